@@ -13,6 +13,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -93,20 +94,11 @@ func MigrateEnterpriseProject(client *golangsdk.ServiceClient, region, targetEPS
 
 // GetEipIDbyAddress returns the EIP ID of address when success.
 func GetEipIDbyAddress(client *golangsdk.ServiceClient, address, epsID string) (string, error) {
-	listOpts := &eips.ListOpts{
-		PublicIp:            []string{address},
-		EnterpriseProjectId: epsID,
-	}
-	pages, err := eips.List(client, listOpts).AllPages()
+	allEips, err := ListEipsByAddress(client, address, epsID)
 	if err != nil {
 		return "", err
 	}
 
-	allEips, err := eips.ExtractPublicIPs(pages)
-	if err != nil {
-		return "", fmtp.Errorf("Unable to retrieve eips: %s ", err)
-	}
-
 	total := len(allEips)
 	if total == 0 {
 		return "", fmtp.Errorf("queried none results with %s", address)
@@ -117,6 +109,41 @@ func GetEipIDbyAddress(client *golangsdk.ServiceClient, address, epsID string) (
 	return allEips[0].ID, nil
 }
 
+// ListEipsByAddress is used to query the EIPs by the IPv4 or IPv6 address, the IPv6 address can not be used as a
+// query parameter, so the IPv6 EIPs are filtered by the public_ipv6_address.
+func ListEipsByAddress(client *golangsdk.ServiceClient, address, epsID string) ([]eips.PublicIp, error) {
+	listOpts := &eips.ListOpts{
+		EnterpriseProjectId: epsID,
+	}
+	ipv6Address := net.ParseIP(address)
+	if ipv6Address != nil && ipv6Address.To4() == nil {
+		listOpts.IPVersion = 6
+	} else {
+		listOpts.PublicIp = []string{address}
+	}
+
+	pages, err := eips.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allEips, err := eips.ExtractPublicIPs(pages)
+	if err != nil {
+		return nil, fmtp.Errorf("Unable to retrieve eips: %s ", err)
+	}
+	if listOpts.IPVersion != 6 {
+		return allEips, nil
+	}
+
+	result := make([]eips.PublicIp, 0, 1)
+	for _, eip := range allEips {
+		if ip := net.ParseIP(eip.PublicIpv6Address); ip != nil && ip.Equal(ipv6Address) {
+			result = append(result, eip)
+		}
+	}
+	return result, nil
+}
+
 // CheckDeleted checks the error to see if it's a 404 (Not Found) and, if so,
 // sets the resource ID to the empty string instead of throwing an error.
 func CheckDeleted(d *schema.ResourceData, err error, msg string) error {
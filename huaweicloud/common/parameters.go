@@ -0,0 +1,120 @@
+package common
+
+import (
+	"sort"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ParametersSchema returns the schema of the database parameters which are applied to the instance directly.
+func ParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// PendingRestartParametersSchema returns the schema of the parameter names which take effect after the restart.
+func PendingRestartParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// ExpandChangedParameters returns the parameters which are added or whose values are changed. The parameters removed
+// from the configuration are not reset, they keep the current values.
+func ExpandChangedParameters(d *schema.ResourceData) map[string]string {
+	oldRaw, newRaw := d.GetChange("parameters")
+	oldValues := make(map[string]string)
+	for _, v := range oldRaw.(*schema.Set).List() {
+		param := v.(map[string]interface{})
+		oldValues[param["name"].(string)] = param["value"].(string)
+	}
+
+	result := make(map[string]string)
+	for _, v := range newRaw.(*schema.Set).List() {
+		param := v.(map[string]interface{})
+		name, value := param["name"].(string), param["value"].(string)
+		if oldValue, ok := oldValues[name]; !ok || oldValue != value {
+			result[name] = value
+		}
+	}
+	return result
+}
+
+// FlattenConfiguredParameters returns the current values of the parameters which are configured, the other
+// parameters of the instance are ignored.
+func FlattenConfiguredParameters(d *schema.ResourceData, values map[string]string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("parameters").(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+		if value, ok := values[name]; ok {
+			result = append(result, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+	return result
+}
+
+// MergePendingRestartParameters returns the sorted names of the parameters in both lists without duplicates.
+func MergePendingRestartParameters(pending, names []string) []string {
+	set := make(map[string]bool)
+	for _, name := range pending {
+		set[name] = true
+	}
+	for _, name := range names {
+		set[name] = true
+	}
+
+	result := make([]string, 0, len(set))
+	for name := range set {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// GetPendingRestartParameters returns the sorted names of the parameters which are modified successfully but are not
+// applied until the instance is restarted, according to the parameter modification histories of the instance.
+func GetPendingRestartParameters(client *golangsdk.ServiceClient, historiesURL string) ([]string, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", historiesURL, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]string, 0)
+	for _, history := range utils.PathSearch("histories", respBody, make([]interface{}, 0)).([]interface{}) {
+		if utils.PathSearch("update_result", history, "").(string) == "SUCCESS" &&
+			!utils.PathSearch("applied", history, true).(bool) {
+			pending = append(pending, utils.PathSearch("parameter_name", history, "").(string))
+		}
+	}
+	return MergePendingRestartParameters(nil, pending), nil
+}
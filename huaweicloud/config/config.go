@@ -216,6 +216,26 @@ func (c *Config) ObjectStorageClient(region string) (*obs.ObsClient, error) {
 	return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, clientConfigure, userAgentConfigure)
 }
 
+// ObjectStorageClientWithV4Signature creates the OBS client which signs the requests with the V4 signature.
+// All query parameters are signed by the V4 signature, so the client can access the sub-resources which are unknown to
+// the signature of the other clients, e.g. the bucket inventory.
+func (c *Config) ObjectStorageClientWithV4Signature(region string) (*obs.ObsClient, error) {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
+	}
+
+	clientConfigure := obs.WithHttpClient(&c.DomainClient.HTTPClient)
+	userAgentConfigure := obs.WithUserAgent(buildObsUserAgent())
+	obsEndpoint := getObsEndpoint(c, region)
+	if c.SecurityToken != "" {
+		return obs.New(c.AccessKey, c.SecretKey, obsEndpoint,
+			obs.WithSignature(obs.SignatureV4), obs.WithRegion(region), obs.WithSecurityToken(c.SecurityToken),
+			clientConfigure, userAgentConfigure)
+	}
+	return obs.New(c.AccessKey, c.SecretKey, obsEndpoint, obs.WithSignature(obs.SignatureV4), obs.WithRegion(region),
+		clientConfigure, userAgentConfigure)
+}
+
 func buildObsUserAgent() string {
 	var agent string = providerUserAgent
 	if customUserAgent := os.Getenv("HW_TF_CUSTOM_UA"); customUserAgent != "" {
@@ -107,6 +107,13 @@ var allServiceCatalog = map[string]ServiceCatalog{
 		WithOutProjectID: true,
 		Product:          "BSS",
 	},
+	"ram": {
+		Name:             "ram",
+		Version:          "v1",
+		Scope:            "global",
+		WithOutProjectID: true,
+		Product:          "RAM",
+	},
 
 	// ******* catalog for Compute *******
 	"ecs": {
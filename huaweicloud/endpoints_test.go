@@ -123,6 +123,18 @@ func TestAccServiceEndpoints_Global(t *testing.T) {
 		t.Fatalf("BSS v2 endpoint: expected %s but got %s", green(expectedURL), yellow(actualURL))
 	}
 	t.Logf("BSS v2 endpoint:\t %s", actualURL)
+
+	// test the endpoint of RAM service
+	serviceClient, err = config.NewServiceClient("ram", HW_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating HuaweiCloud RAM client: %s", err)
+	}
+	expectedURL = fmt.Sprintf("https://ram.%s/v1/", config.Cloud)
+	actualURL = serviceClient.ResourceBaseURL()
+	if actualURL != expectedURL {
+		t.Fatalf("RAM endpoint: expected %s but got %s", green(expectedURL), yellow(actualURL))
+	}
+	t.Logf("RAM endpoint:\t %s", actualURL)
 }
 
 func TestAccServiceEndpoints_Management(t *testing.T) {
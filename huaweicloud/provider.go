@@ -67,9 +67,11 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/modelarts"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mpc"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/nat"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/obs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/oms"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/projectman"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ram"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rf"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/scm"
@@ -373,6 +375,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_availability_zones": DataSourceAvailabilityZones(),
 
 			"huaweicloud_bms_flavors": bms.DataSourceBmsFlavors(),
+			"huaweicloud_cbr_backups": cbr.DataSourceBackups(),
 			"huaweicloud_cbr_vaults":  cbr.DataSourceCbrVaultsV3(),
 
 			"huaweicloud_cbh_instances": cbh.DataSourceCbhInstances(),
@@ -401,9 +404,12 @@ func Provider() *schema.Provider {
 			"huaweicloud_csms_secret_version": dew.DataSourceDewCsmsSecret(),
 			"huaweicloud_css_flavors":         css.DataSourceCssFlavors(),
 
-			"huaweicloud_dcs_flavors":        dcs.DataSourceDcsFlavorsV2(),
-			"huaweicloud_dcs_maintainwindow": dcs.DataSourceDcsMaintainWindow(),
-			"huaweicloud_dcs_instances":      dcs.DataSourceDcsInstance(),
+			"huaweicloud_cts_traces": cts.DataSourceCTSTraces(),
+
+			"huaweicloud_dcs_flavors":             dcs.DataSourceDcsFlavorsV2(),
+			"huaweicloud_dcs_maintainwindow":      dcs.DataSourceDcsMaintainWindow(),
+			"huaweicloud_dcs_instances":           dcs.DataSourceDcsInstance(),
+			"huaweicloud_dcs_key_analysis_result": dcs.DataSourceDcsKeyAnalysisResult(),
 
 			"huaweicloud_dds_flavors":   dds.DataSourceDDSFlavorV3(),
 			"huaweicloud_dds_instances": dds.DataSourceDdsInstance(),
@@ -418,6 +424,7 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_enterprise_project": eps.DataSourceEnterpriseProject(),
 
+			"huaweicloud_er_attachments":  er.DataSourceAttachments(),
 			"huaweicloud_er_route_tables": er.DataSourceRouteTables(),
 
 			"huaweicloud_evs_volumes":      evs.DataSourceEvsVolumesV2(),
@@ -437,11 +444,14 @@ func Provider() *schema.Provider {
 			"huaweicloud_gaussdb_mysql_instances":              gaussdb.DataSourceGaussDBMysqlInstances(),
 			"huaweicloud_gaussdb_redis_instance":               gaussdb.DataSourceGaussRedisInstance(),
 
-			"huaweicloud_identity_role":        iam.DataSourceIdentityRoleV3(),
-			"huaweicloud_identity_custom_role": iam.DataSourceIdentityCustomRole(),
-			"huaweicloud_identity_group":       iam.DataSourceIdentityGroup(),
-			"huaweicloud_identity_projects":    iam.DataSourceIdentityProjects(),
-			"huaweicloud_identity_users":       iam.DataSourceIdentityUsers(),
+			"huaweicloud_hss_hosts": hss.DataSourceHosts(),
+
+			"huaweicloud_identity_role":            iam.DataSourceIdentityRoleV3(),
+			"huaweicloud_identity_custom_role":     iam.DataSourceIdentityCustomRole(),
+			"huaweicloud_identity_group":           iam.DataSourceIdentityGroup(),
+			"huaweicloud_identity_projects":        iam.DataSourceIdentityProjects(),
+			"huaweicloud_identity_users":           iam.DataSourceIdentityUsers(),
+			"huaweicloud_identity_policy_document": iam.DataSourceIdentityPolicyDocument(),
 
 			"huaweicloud_iec_bandwidths":     dataSourceIECBandWidths(),
 			"huaweicloud_iec_eips":           dataSourceIECNetworkEips(),
@@ -459,18 +469,23 @@ func Provider() *schema.Provider {
 			"huaweicloud_images_image":  ims.DataSourceImagesImageV2(),
 			"huaweicloud_images_images": ims.DataSourceImagesImages(),
 
-			"huaweicloud_kms_key":      DataSourceKmsKeyV1(),
-			"huaweicloud_kms_data_key": DataSourceKmsDataKeyV1(),
-			"huaweicloud_kps_keypairs": dew.DataSourceKeypairs(),
+			"huaweicloud_kms_key":                    DataSourceKmsKeyV1(),
+			"huaweicloud_kms_data_key":               DataSourceKmsDataKeyV1(),
+			"huaweicloud_kms_decrypted_data":         dew.DataSourceKmsDecryptedData(),
+			"huaweicloud_kms_encrypted_data":         dew.DataSourceKmsEncryptedData(),
+			"huaweicloud_kms_signature":              dew.DataSourceKmsSignature(),
+			"huaweicloud_kms_signature_verification": dew.DataSourceKmsSignatureVerification(),
+			"huaweicloud_kps_keypairs":               dew.DataSourceKeypairs(),
 
 			"huaweicloud_lb_listeners":    lb.DataSourceListeners(),
 			"huaweicloud_lb_loadbalancer": lb.DataSourceELBV2Loadbalancer(),
 			"huaweicloud_lb_certificate":  lb.DataSourceLBCertificateV2(),
 			"huaweicloud_lb_pools":        lb.DataSourcePools(),
 
-			"huaweicloud_elb_certificate": elb.DataSourceELBCertificateV3(),
-			"huaweicloud_elb_flavors":     elb.DataSourceElbFlavorsV3(),
-			"huaweicloud_elb_pools":       elb.DataSourcePools(),
+			"huaweicloud_elb_certificate":   elb.DataSourceELBCertificateV3(),
+			"huaweicloud_elb_flavors":       elb.DataSourceElbFlavorsV3(),
+			"huaweicloud_elb_pools":         elb.DataSourcePools(),
+			"huaweicloud_elb_loadbalancers": elb.DataSourceElbLoadbalances(),
 
 			"huaweicloud_nat_gateway":          DataSourceNatGatewayV2(),
 			"huaweicloud_networking_port":      vpc.DataSourceNetworkingPortV2(),
@@ -519,6 +534,8 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_vpcep_public_services": vpcep.DataSourceVPCEPPublicServices(),
 
+			"huaweicloud_vpn_connections": vpn.DataSourceConnections(),
+
 			"huaweicloud_waf_certificate":         waf.DataSourceWafCertificateV1(),
 			"huaweicloud_waf_policies":            waf.DataSourceWafPoliciesV1(),
 			"huaweicloud_waf_dedicated_instances": waf.DataSourceWafDedicatedInstancesV1(),
@@ -616,8 +633,10 @@ func Provider() *schema.Provider {
 			"huaweicloud_bms_instance": bms.ResourceBmsInstance(),
 			"huaweicloud_bcs_instance": resourceBCSInstanceV2(),
 
-			"huaweicloud_cbr_policy": cbr.ResourceCBRPolicyV3(),
-			"huaweicloud_cbr_vault":  cbr.ResourceVault(),
+			"huaweicloud_cbr_backup":  cbr.ResourceBackup(),
+			"huaweicloud_cbr_policy":  cbr.ResourceCBRPolicyV3(),
+			"huaweicloud_cbr_restore": cbr.ResourceRestore(),
+			"huaweicloud_cbr_vault":   cbr.ResourceVault(),
 
 			"huaweicloud_cbh_instance": cbh.ResourceCBHInstance(),
 
@@ -646,7 +665,14 @@ func Provider() *schema.Provider {
 			"huaweicloud_cdn_domain":    resourceCdnDomainV1(),
 			"huaweicloud_ces_alarmrule": ces.ResourceAlarmRule(),
 
-			"huaweicloud_cfw_protection_rule": cfw.ResourceProtectionRule(),
+			"huaweicloud_cfw_address_group":        cfw.ResourceAddressGroup(),
+			"huaweicloud_cfw_address_group_member": cfw.ResourceAddressGroupMember(),
+			"huaweicloud_cfw_black_white_list":     cfw.ResourceBlackWhiteList(),
+			"huaweicloud_cfw_eip_protection":       cfw.ResourceEipProtection(),
+			"huaweicloud_cfw_ips_antivirus_config": cfw.ResourceIpsAntivirusConfig(),
+			"huaweicloud_cfw_protection_rule":      cfw.ResourceProtectionRule(),
+			"huaweicloud_cfw_service_group":        cfw.ResourceServiceGroup(),
+			"huaweicloud_cfw_service_group_member": cfw.ResourceServiceGroupMember(),
 
 			"huaweicloud_cloudtable_cluster": cloudtable.ResourceCloudTableCluster(),
 
@@ -666,7 +692,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_cse_microservice_engine":   cse.ResourceMicroserviceEngine(),
 			"huaweicloud_cse_microservice_instance": cse.ResourceMicroserviceInstance(),
 
-			"huaweicloud_csms_secret": dew.ResourceCsmsSecret(),
+			"huaweicloud_csms_event":                dew.ResourceCsmsEvent(),
+			"huaweicloud_csms_secret":               dew.ResourceCsmsSecret(),
+			"huaweicloud_csms_secret_version_stage": dew.ResourceCsmsSecretVersionStage(),
 
 			"huaweicloud_css_cluster":   css.ResourceCssCluster(),
 			"huaweicloud_css_snapshot":  css.ResourceCssSnapshot(),
@@ -677,11 +705,17 @@ func Provider() *schema.Provider {
 			"huaweicloud_dc_virtual_gateway":   dc.ResourceVirtualGateway(),
 			"huaweicloud_dc_virtual_interface": dc.ResourceVirtualInterface(),
 
-			"huaweicloud_dcs_instance": dcs.ResourceDcsInstance(),
+			"huaweicloud_dcs_instance":        dcs.ResourceDcsInstance(),
+			"huaweicloud_dcs_account":         dcs.ResourceDcsAccount(),
+			"huaweicloud_dcs_hotkey_analysis": dcs.ResourceDcsHotKeyAnalysis(),
+			"huaweicloud_dcs_bigkey_analysis": dcs.ResourceDcsBigKeyAnalysis(),
 
-			"huaweicloud_dds_database_role": dds.ResourceDatabaseRole(),
-			"huaweicloud_dds_database_user": dds.ResourceDatabaseUser(),
-			"huaweicloud_dds_instance":      dds.ResourceDdsInstanceV3(),
+			"huaweicloud_dds_database_role":      dds.ResourceDatabaseRole(),
+			"huaweicloud_dds_database_user":      dds.ResourceDatabaseUser(),
+			"huaweicloud_dds_instance":           dds.ResourceDdsInstanceV3(),
+			"huaweicloud_dds_instance_restore":   dds.ResourceDdsInstanceRestore(),
+			"huaweicloud_dds_parameter_template": dds.ResourceDdsParameterTemplate(),
+			"huaweicloud_dds_backup":             dds.ResourceDdsBackup(),
 
 			"huaweicloud_dis_stream": dis.ResourceDisStream(),
 
@@ -722,16 +756,21 @@ func Provider() *schema.Provider {
 			"huaweicloud_elb_ipgroup":         elb.ResourceIpGroupV3(),
 			"huaweicloud_elb_pool":            elb.ResourcePoolV3(),
 			"huaweicloud_elb_member":          elb.ResourceMemberV3(),
+			"huaweicloud_elb_pool_attachment": elb.ResourcePoolAttachment(),
 			"huaweicloud_elb_logtank":         elb.ResourceLogTank(),
 			"huaweicloud_elb_security_policy": elb.ResourceSecurityPolicy(),
 
 			"huaweicloud_enterprise_project": eps.ResourceEnterpriseProject(),
 
-			"huaweicloud_er_association":    er.ResourceAssociation(),
-			"huaweicloud_er_instance":       er.ResourceInstance(),
-			"huaweicloud_er_propagation":    er.ResourcePropagation(),
-			"huaweicloud_er_route_table":    er.ResourceRouteTable(),
-			"huaweicloud_er_vpc_attachment": er.ResourceVpcAttachment(),
+			"huaweicloud_er_association":         er.ResourceAssociation(),
+			"huaweicloud_er_attachment_accepter": er.ResourceAttachmentAccepter(),
+			"huaweicloud_er_flow_log":            er.ResourceFlowLog(),
+			"huaweicloud_er_instance":            er.ResourceInstance(),
+			"huaweicloud_er_peering_attachment":  er.ResourcePeeringAttachment(),
+			"huaweicloud_er_propagation":         er.ResourcePropagation(),
+			"huaweicloud_er_route_table":         er.ResourceRouteTable(),
+			"huaweicloud_er_static_route":        er.ResourceStaticRoute(),
+			"huaweicloud_er_vpc_attachment":      er.ResourceVpcAttachment(),
 
 			"huaweicloud_evs_snapshot": ResourceEvsSnapshotV2(),
 			"huaweicloud_evs_volume":   evs.ResourceEvsVolume(),
@@ -756,18 +795,25 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_ges_graph": ResourceGesGraphV1(),
 
-			"huaweicloud_hss_host_group": hss.ResourceHostGroup(),
-
-			"huaweicloud_identity_access_key":       iam.ResourceIdentityKey(),
-			"huaweicloud_identity_acl":              iam.ResourceIdentityACL(),
-			"huaweicloud_identity_agency":           iam.ResourceIAMAgencyV3(),
-			"huaweicloud_identity_group":            iam.ResourceIdentityGroupV3(),
-			"huaweicloud_identity_group_membership": iam.ResourceIdentityGroupMembershipV3(),
-			"huaweicloud_identity_project":          iam.ResourceIdentityProjectV3(),
-			"huaweicloud_identity_role":             iam.ResourceIdentityRole(),
-			"huaweicloud_identity_role_assignment":  iam.ResourceIdentityRoleAssignmentV3(),
-			"huaweicloud_identity_user":             iam.ResourceIdentityUserV3(),
-			"huaweicloud_identity_provider":         iam.ResourceIdentityProvider(),
+			"huaweicloud_hss_host_group":      hss.ResourceHostGroup(),
+			"huaweicloud_hss_host_protection": hss.ResourceHostProtection(),
+			"huaweicloud_hss_policy_group":    hss.ResourcePolicyGroup(),
+			"huaweicloud_hss_quotas":          hss.ResourceQuotas(),
+
+			"huaweicloud_identity_access_key":         iam.ResourceIdentityKey(),
+			"huaweicloud_identity_acl":                iam.ResourceIdentityACL(),
+			"huaweicloud_identity_agency":             iam.ResourceIAMAgencyV3(),
+			"huaweicloud_identity_group":              iam.ResourceIdentityGroupV3(),
+			"huaweicloud_identity_group_membership":   iam.ResourceIdentityGroupMembershipV3(),
+			"huaweicloud_identity_project":            iam.ResourceIdentityProjectV3(),
+			"huaweicloud_identity_role":               iam.ResourceIdentityRole(),
+			"huaweicloud_identity_role_assignment":    iam.ResourceIdentityRoleAssignmentV3(),
+			"huaweicloud_identity_user":               iam.ResourceIdentityUserV3(),
+			"huaweicloud_identity_provider":           iam.ResourceIdentityProvider(),
+			"huaweicloud_identity_password_policy":    iam.ResourceIdentityPasswordPolicy(),
+			"huaweicloud_identity_login_policy":       iam.ResourceIdentityLoginPolicy(),
+			"huaweicloud_identity_protection_policy":  iam.ResourceIdentityProtectionPolicy(),
+			"huaweicloud_identity_virtual_mfa_device": iam.ResourceIdentityVirtualMfaDevice(),
 
 			"huaweicloud_iec_eip":                 resourceIecNetworkEip(),
 			"huaweicloud_iec_keypair":             resourceIecKeypair(),
@@ -791,8 +837,10 @@ func Provider() *schema.Provider {
 			"huaweicloud_iotda_device_certificate":  iotda.ResourceDeviceCertificate(),
 			"huaweicloud_iotda_device_linkage_rule": iotda.ResourceDeviceLinkageRule(),
 
-			"huaweicloud_kms_key":     ResourceKmsKeyV1(),
-			"huaweicloud_kps_keypair": dew.ResourceKeypair(),
+			"huaweicloud_kms_key":          ResourceKmsKeyV1(),
+			"huaweicloud_kms_grant":        dew.ResourceKmsGrant(),
+			"huaweicloud_kms_key_material": dew.ResourceKmsKeyMaterial(),
+			"huaweicloud_kps_keypair":      dew.ResourceKeypair(),
 
 			"huaweicloud_lb_certificate":  lb.ResourceCertificateV2(),
 			"huaweicloud_lb_l7policy":     lb.ResourceL7PolicyV2(),
@@ -832,9 +880,14 @@ func Provider() *schema.Provider {
 			"huaweicloud_mrs_cluster": ResourceMRSClusterV1(),
 			"huaweicloud_mrs_job":     ResourceMRSJobV1(),
 
-			"huaweicloud_nat_dnat_rule": ResourceNatDnatRuleV2(),
-			"huaweicloud_nat_gateway":   ResourceNatGatewayV2(),
-			"huaweicloud_nat_snat_rule": ResourceNatSnatRuleV2(),
+			"huaweicloud_nat_dnat_rule":          ResourceNatDnatRuleV2(),
+			"huaweicloud_nat_dnat_rule_batch":    nat.ResourceDnatRuleBatch(),
+			"huaweicloud_nat_gateway":            ResourceNatGatewayV2(),
+			"huaweicloud_nat_snat_rule":          ResourceNatSnatRuleV2(),
+			"huaweicloud_nat_private_gateway":    nat.ResourcePrivateGateway(),
+			"huaweicloud_nat_private_transit_ip": nat.ResourcePrivateTransitIp(),
+			"huaweicloud_nat_private_snat_rule":  nat.ResourcePrivateSnatRule(),
+			"huaweicloud_nat_private_dnat_rule":  nat.ResourcePrivateDnatRule(),
 
 			"huaweicloud_network_acl":              ResourceNetworkACL(),
 			"huaweicloud_network_acl_rule":         ResourceNetworkACLRule(),
@@ -844,16 +897,24 @@ func Provider() *schema.Provider {
 			"huaweicloud_networking_vip":           vpc.ResourceNetworkingVip(),
 			"huaweicloud_networking_vip_associate": vpc.ResourceNetworkingVIPAssociateV2(),
 
-			"huaweicloud_obs_bucket":        obs.ResourceObsBucket(),
-			"huaweicloud_obs_bucket_object": obs.ResourceObsBucketObject(),
-			"huaweicloud_obs_bucket_policy": obs.ResourceObsBucketPolicy(),
+			"huaweicloud_obs_bucket":              obs.ResourceObsBucket(),
+			"huaweicloud_obs_bucket_object":       obs.ResourceObsBucketObject(),
+			"huaweicloud_obs_bucket_policy":       obs.ResourceObsBucketPolicy(),
+			"huaweicloud_obs_bucket_replication":  obs.ResourceObsBucketReplication(),
+			"huaweicloud_obs_bucket_notification": obs.ResourceObsBucketNotification(),
+			"huaweicloud_obs_bucket_inventory":    obs.ResourceObsBucketInventory(),
+			"huaweicloud_obs_bucket_objects_sync": obs.ResourceObsBucketObjectsSync(),
 
 			"huaweicloud_oms_migration_task": oms.ResourceMigrationTask(),
 
+			"huaweicloud_ram_resource_share":          ram.ResourceShare(),
+			"huaweicloud_ram_resource_share_accepter": ram.ResourceShareAccepter(),
+
 			"huaweicloud_rds_account":               rds.ResourceRdsAccount(),
 			"huaweicloud_rds_database":              rds.ResourceRdsDatabase(),
 			"huaweicloud_rds_database_privilege":    rds.ResourceRdsDatabasePrivilege(),
 			"huaweicloud_rds_instance":              rds.ResourceRdsInstance(),
+			"huaweicloud_rds_instance_restore":      rds.ResourceRdsInstanceRestore(),
 			"huaweicloud_rds_parametergroup":        rds.ResourceRdsConfiguration(),
 			"huaweicloud_rds_read_replica_instance": rds.ResourceRdsReadReplicaInstance(),
 			"huaweicloud_rds_backup":                rds.ResourceBackup(),
@@ -865,9 +926,12 @@ func Provider() *schema.Provider {
 			"huaweicloud_servicestage_repo_token_authorization":    servicestage.ResourceRepoTokenAuth(),
 			"huaweicloud_servicestage_repo_password_authorization": servicestage.ResourceRepoPwdAuth(),
 
-			"huaweicloud_sfs_access_rule": ResourceSFSAccessRuleV2(),
-			"huaweicloud_sfs_file_system": ResourceSFSFileSystemV2(),
-			"huaweicloud_sfs_turbo":       ResourceSFSTurbo(),
+			"huaweicloud_sfs_access_rule":      ResourceSFSAccessRuleV2(),
+			"huaweicloud_sfs_file_system":      ResourceSFSFileSystemV2(),
+			"huaweicloud_sfs_turbo":            ResourceSFSTurbo(),
+			"huaweicloud_sfs_turbo_dir_quota":  sfs.ResourceTurboDirQuota(),
+			"huaweicloud_sfs_turbo_obs_target": sfs.ResourceTurboObsTarget(),
+			"huaweicloud_sfs_turbo_perm_rule":  sfs.ResourceTurboPermRule(),
 
 			"huaweicloud_smn_topic":        smn.ResourceTopic(),
 			"huaweicloud_smn_subscription": smn.ResourceSubscription(),
@@ -890,9 +954,10 @@ func Provider() *schema.Provider {
 			"huaweicloud_vod_transcoding_template_group": vod.ResourceTranscodingTemplateGroup(),
 			"huaweicloud_vod_watermark_template":         vod.ResourceWatermarkTemplate(),
 
-			"huaweicloud_vpc_bandwidth":     eip.ResourceVpcBandWidthV2(),
-			"huaweicloud_vpc_eip":           eip.ResourceVpcEIPV1(),
-			"huaweicloud_vpc_eip_associate": eip.ResourceEIPAssociate(),
+			"huaweicloud_vpc_bandwidth":           eip.ResourceVpcBandWidthV2(),
+			"huaweicloud_vpc_bandwidth_associate": eip.ResourceBandWidthAssociate(),
+			"huaweicloud_vpc_eip":                 eip.ResourceVpcEIPV1(),
+			"huaweicloud_vpc_eip_associate":       eip.ResourceEIPAssociate(),
 
 			"huaweicloud_vpc_peering_connection":          vpc.ResourceVpcPeeringConnectionV2(),
 			"huaweicloud_vpc_peering_connection_accepter": vpc.ResourceVpcPeeringConnectionAccepterV2(),
@@ -906,24 +971,34 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpcep_endpoint": vpcep.ResourceVPCEndpoint(),
 			"huaweicloud_vpcep_service":  vpcep.ResourceVPCEndpointService(),
 
-			"huaweicloud_vpn_gateway":          vpn.ResourceGateway(),
-			"huaweicloud_vpn_customer_gateway": vpn.ResourceCustomerGateway(),
-			"huaweicloud_vpn_connection":       vpn.ResourceConnection(),
-
-			"huaweicloud_scm_certificate": scm.ResourceScmCertificate(),
-
-			"huaweicloud_waf_certificate":                waf.ResourceWafCertificateV1(),
-			"huaweicloud_waf_cloud_instance":             waf.ResourceCloudInstance(),
-			"huaweicloud_waf_domain":                     waf.ResourceWafDomainV1(),
-			"huaweicloud_waf_policy":                     waf.ResourceWafPolicyV1(),
-			"huaweicloud_waf_rule_blacklist":             waf.ResourceWafRuleBlackListV1(),
-			"huaweicloud_waf_rule_data_masking":          waf.ResourceWafRuleDataMaskingV1(),
-			"huaweicloud_waf_rule_web_tamper_protection": waf.ResourceWafRuleWebTamperProtectionV1(),
-			"huaweicloud_waf_dedicated_instance":         waf.ResourceWafDedicatedInstance(),
-			"huaweicloud_waf_dedicated_domain":           waf.ResourceWafDedicatedDomainV1(),
-			"huaweicloud_waf_instance_group":             waf.ResourceWafInstanceGroup(),
-			"huaweicloud_waf_instance_group_associate":   waf.ResourceWafInstGroupAssociate(),
-			"huaweicloud_waf_reference_table":            waf.ResourceWafReferenceTableV1(),
+			"huaweicloud_vpn_gateway":                 vpn.ResourceGateway(),
+			"huaweicloud_vpn_customer_gateway":        vpn.ResourceCustomerGateway(),
+			"huaweicloud_vpn_connection":              vpn.ResourceConnection(),
+			"huaweicloud_vpn_connection_health_check": vpn.ResourceConnectionHealthCheck(),
+
+			"huaweicloud_scm_certificate":            scm.ResourceScmCertificate(),
+			"huaweicloud_scm_certificate_deployment": scm.ResourceScmCertificateDeployment(),
+			"huaweicloud_scm_managed_certificate":    scm.ResourceScmManagedCertificate(),
+
+			"huaweicloud_waf_certificate":                         waf.ResourceWafCertificateV1(),
+			"huaweicloud_waf_cloud_instance":                      waf.ResourceCloudInstance(),
+			"huaweicloud_waf_domain":                              waf.ResourceWafDomainV1(),
+			"huaweicloud_waf_policy":                              waf.ResourceWafPolicyV1(),
+			"huaweicloud_waf_rule_anti_crawler":                   waf.ResourceRuleAntiCrawler(),
+			"huaweicloud_waf_rule_blacklist":                      waf.ResourceWafRuleBlackListV1(),
+			"huaweicloud_waf_rule_cc_protection":                  waf.ResourceRuleCCProtection(),
+			"huaweicloud_waf_rule_data_masking":                   waf.ResourceWafRuleDataMaskingV1(),
+			"huaweicloud_waf_rule_geolocation_access_control":     waf.ResourceRuleGeolocation(),
+			"huaweicloud_waf_rule_global_protection_whitelist":    waf.ResourceRuleGlobalProtectionWhitelist(),
+			"huaweicloud_waf_rule_information_leakage_prevention": waf.ResourceRuleLeakagePrevention(),
+			"huaweicloud_waf_rule_known_attack_source":            waf.ResourceRuleKnownAttackSource(),
+			"huaweicloud_waf_rule_precise_protection":             waf.ResourceRulePreciseProtection(),
+			"huaweicloud_waf_rule_web_tamper_protection":          waf.ResourceWafRuleWebTamperProtectionV1(),
+			"huaweicloud_waf_dedicated_instance":                  waf.ResourceWafDedicatedInstance(),
+			"huaweicloud_waf_dedicated_domain":                    waf.ResourceWafDedicatedDomainV1(),
+			"huaweicloud_waf_instance_group":                      waf.ResourceWafInstanceGroup(),
+			"huaweicloud_waf_instance_group_associate":            waf.ResourceWafInstGroupAssociate(),
+			"huaweicloud_waf_reference_table":                     waf.ResourceWafReferenceTableV1(),
 
 			"huaweicloud_workspace_desktop": workspace.ResourceDesktop(),
 			"huaweicloud_workspace_service": workspace.ResourceService(),
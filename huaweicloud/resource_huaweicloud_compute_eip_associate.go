@@ -54,7 +54,7 @@ func ResourceComputeFloatingIPAssociateV2() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IsIPv4Address,
+				ValidateFunc: utils.ValidateIP,
 				ExactlyOneOf: []string{"bandwidth_id"},
 			},
 			"bandwidth_id": {
@@ -330,21 +330,11 @@ func getComputeInstancePortIDbyFixedIP(client *golangsdk.ServiceClient, config *
 }
 
 func getFloatingIPbyAddress(client *golangsdk.ServiceClient, floatingIP, epsID string) (*eips.PublicIp, error) {
-	listOpts := &eips.ListOpts{
-		PublicIp:            []string{floatingIP},
-		EnterpriseProjectId: epsID,
-	}
-
-	pages, err := eips.List(client, listOpts).AllPages()
+	allEips, err := common.ListEipsByAddress(client, floatingIP, epsID)
 	if err != nil {
 		return nil, err
 	}
 
-	allEips, err := eips.ExtractPublicIPs(pages)
-	if err != nil {
-		return nil, fmtp.Errorf("Unable to retrieve eips: %s ", err)
-	}
-
 	if len(allEips) != 1 {
 		return &eips.PublicIp{}, fmtp.Errorf("can not find the EIP by %s", floatingIP)
 	}
@@ -21,6 +21,7 @@ const (
 	EnabledState          = "2"
 	DisabledState         = "3"
 	PendingDeletionState  = "4"
+	PendingImportState    = "5"
 )
 
 func ResourceKmsKeyV1() *schema.Resource {
@@ -54,6 +55,20 @@ func ResourceKmsKeyV1() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"key_usage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENCRYPT_DECRYPT", "SIGN_VERIFY"}, false),
+			},
+			"origin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"kms", "external"}, false),
+			},
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -119,6 +134,30 @@ func ResourceKmsKeyV1() *schema.Resource {
 	}
 }
 
+// kmsKeyCreateOpts is the creation options of the KMS key, the origin of the key is not supported by keys.CreateOpts.
+type kmsKeyCreateOpts struct {
+	keys.CreateOpts
+	Origin string
+}
+
+// kmsKeyUsageInfo is used to parse the key usage from the key details.
+type kmsKeyUsageInfo struct {
+	KeyInfo struct {
+		KeyUsage string `json:"key_usage"`
+	} `json:"key_info"`
+}
+
+func (opts kmsKeyCreateOpts) ToKeyCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToKeyCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Origin != "" {
+		b["origin"] = opts.Origin
+	}
+	return b, nil
+}
+
 func resourceKmsKeyValidation(d *schema.ResourceData) error {
 	_, rotationEnabled := d.GetOk("rotation_enabled")
 	_, hasInterval := d.GetOk("rotation_interval")
@@ -140,11 +179,15 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	createOpts := &keys.CreateOpts{
-		KeyAlias:            d.Get("key_alias").(string),
-		KeyDescription:      d.Get("key_description").(string),
-		KeySpec:             d.Get("key_algorithm").(string),
-		EnterpriseProjectID: GetEnterpriseProjectID(d, config),
+	createOpts := kmsKeyCreateOpts{
+		CreateOpts: keys.CreateOpts{
+			KeyAlias:            d.Get("key_alias").(string),
+			KeyDescription:      d.Get("key_description").(string),
+			KeySpec:             d.Get("key_algorithm").(string),
+			KeyUsage:            d.Get("key_usage").(string),
+			EnterpriseProjectID: GetEnterpriseProjectID(d, config),
+		},
+		Origin: d.Get("origin").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -156,11 +199,16 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 	// Store the key ID
 	d.SetId(v.KeyID)
 
-	// Wait for the key to become enabled.
+	// Wait for the key to become enabled, the key with external origin is pending import until the key material is
+	// imported.
+	targetState := EnabledState
+	if createOpts.Origin == "external" {
+		targetState = PendingImportState
+	}
 	log.Printf("[DEBUG] Waiting for KMS key (%s) to become enabled", v.KeyID)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
+		Target:     []string{targetState},
 		Refresh:    keyV1StateRefreshFunc(kmsKeyV1Client, v.KeyID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
@@ -174,7 +222,7 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 			v.KeyID, err)
 	}
 
-	if !d.Get("is_enabled").(bool) {
+	if !d.Get("is_enabled").(bool) && targetState == EnabledState {
 		key, err := keys.DisableKey(kmsKeyV1Client, v.KeyID).ExtractKeyInfo()
 		if err != nil {
 			return fmt.Errorf("error disabling KMS key: %s", err)
@@ -227,10 +275,16 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating KMS key client: %s", err)
 	}
 
-	v, err := keys.Get(kmsKeyV1Client, d.Id()).ExtractKeyInfo()
+	getResult := keys.Get(kmsKeyV1Client, d.Id())
+	v, err := getResult.ExtractKeyInfo()
 	if err != nil {
 		return CheckDeleted(d, err, "failed to retrieve key")
 	}
+	// The key usage is not parsed by keys.Key.
+	var usage kmsKeyUsageInfo
+	if err = getResult.ExtractInto(&usage); err != nil {
+		log.Printf("[WARN] failed to parse the usage of KMS key (%s): %s", d.Id(), err)
+	}
 
 	log.Printf("[DEBUG] Kms key %s: %+v", d.Id(), v)
 	if v.KeyState == PendingDeletionState {
@@ -246,9 +300,14 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("region", kmsRegion)
 	d.Set("key_description", v.KeyDescription)
 	d.Set("key_algorithm", v.KeySpec)
+	d.Set("key_usage", usage.KeyInfo.KeyUsage)
+	d.Set("origin", v.Origin)
 	d.Set("creation_date", v.CreationDate)
 	d.Set("scheduled_deletion_date", v.ScheduledDeletionDate)
-	d.Set("is_enabled", v.KeyState == EnabledState)
+	// The key waiting for the key material import cannot be enabled or disabled, keep the value in the configuration.
+	if v.KeyState != PendingImportState {
+		d.Set("is_enabled", v.KeyState == EnabledState)
+	}
 	d.Set("default_key_flag", v.DefaultKeyFlag)
 	d.Set("expiration_time", v.ExpirationTime)
 	d.Set("enterprise_project_id", v.EnterpriseProjectID)
@@ -28,6 +28,7 @@ func TestAccKmsKey_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKmsKeyExists(resourceName, &key),
 					resource.TestCheckResourceAttr(resourceName, "key_alias", keyAlias),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
 					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "region", HW_REGION_NAME),
 				),
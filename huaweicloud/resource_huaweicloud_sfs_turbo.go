@@ -26,6 +26,7 @@ func ResourceSFSTurbo() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -239,15 +240,15 @@ func resourceSFSTurboUpdate(d *schema.ResourceData, meta interface{}) error {
 		stateConf := &resource.StateChangeConf{
 			Pending:    []string{"121"},
 			Target:     []string{"221", "200"},
-			Refresh:    waitForSFSTurboSubStatus(sfsClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Refresh:    waitForSFSTurboExpanded(sfsClient, d.Id(), newsize.(int)),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 5 * time.Second,
 		}
 
 		_, err = stateConf.WaitForState()
 		if err != nil {
-			return fmtp.Errorf("Error deleting HuaweiCloud SFS Turbo: %s", err)
+			return fmtp.Errorf("Error waiting for SFS Turbo (%s) to be expanded: %s", d.Id(), err)
 		}
 	}
 
@@ -319,3 +320,27 @@ func waitForSFSTurboSubStatus(sfsClient *golangsdk.ServiceClient, shareId string
 		return r, status, nil
 	}
 }
+
+// waitForSFSTurboExpanded returns the sub-status of the SFS Turbo during the expansion, which is 121 during the
+// expansion, 221 for success and 321 for failure. The expansion is also pending until the new size takes effect.
+func waitForSFSTurboExpanded(sfsClient *golangsdk.ServiceClient, shareId string, newSize int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r, status, err := waitForSFSTurboSubStatus(sfsClient, shareId)()
+		if err != nil {
+			return r, status, err
+		}
+		if status == "321" {
+			return r, status, fmtp.Errorf("the expansion of SFS Turbo is failed")
+		}
+		share, ok := r.(*shares.Turbo)
+		if !ok || share == nil {
+			return r, status, fmtp.Errorf("the SFS Turbo is not found")
+		}
+
+		if fsize, err := strconv.ParseFloat(share.Size, 64); err != nil || int(fsize) != newSize {
+			logp.Printf("[DEBUG] The size of SFS Turbo (%s) is %s GB, waiting for %d GB", shareId, share.Size, newSize)
+			return r, "121", nil
+		}
+		return r, status, nil
+	}
+}
@@ -25,17 +25,20 @@ var (
 	HW_ENTERPRISE_PROJECT_ID_TEST         = os.Getenv("HW_ENTERPRISE_PROJECT_ID_TEST")
 	HW_ENTERPRISE_MIGRATE_PROJECT_ID_TEST = os.Getenv("HW_ENTERPRISE_MIGRATE_PROJECT_ID_TEST")
 
-	HW_FLAVOR_ID             = os.Getenv("HW_FLAVOR_ID")
-	HW_FLAVOR_NAME           = os.Getenv("HW_FLAVOR_NAME")
-	HW_IMAGE_ID              = os.Getenv("HW_IMAGE_ID")
-	HW_IMAGE_NAME            = os.Getenv("HW_IMAGE_NAME")
-	HW_VPC_ID                = os.Getenv("HW_VPC_ID")
-	HW_NETWORK_ID            = os.Getenv("HW_NETWORK_ID")
-	HW_SUBNET_ID             = os.Getenv("HW_SUBNET_ID")
-	HW_ENTERPRISE_PROJECT_ID = os.Getenv("HW_ENTERPRISE_PROJECT_ID")
-	HW_MAPREDUCE_CUSTOM      = os.Getenv("HW_MAPREDUCE_CUSTOM")
-	HW_ADMIN                 = os.Getenv("HW_ADMIN")
-	HW_OBS_BUCKET_NAME       = os.Getenv("HW_OBS_BUCKET_NAME")
+	HW_FLAVOR_ID              = os.Getenv("HW_FLAVOR_ID")
+	HW_FLAVOR_NAME            = os.Getenv("HW_FLAVOR_NAME")
+	HW_IMAGE_ID               = os.Getenv("HW_IMAGE_ID")
+	HW_IMAGE_NAME             = os.Getenv("HW_IMAGE_NAME")
+	HW_VPC_ID                 = os.Getenv("HW_VPC_ID")
+	HW_NETWORK_ID             = os.Getenv("HW_NETWORK_ID")
+	HW_SUBNET_ID              = os.Getenv("HW_SUBNET_ID")
+	HW_ENTERPRISE_PROJECT_ID  = os.Getenv("HW_ENTERPRISE_PROJECT_ID")
+	HW_MAPREDUCE_CUSTOM       = os.Getenv("HW_MAPREDUCE_CUSTOM")
+	HW_ADMIN                  = os.Getenv("HW_ADMIN")
+	HW_OBS_BUCKET_NAME        = os.Getenv("HW_OBS_BUCKET_NAME")
+	HW_OBS_REPLICATION_AGENCY = os.Getenv("HW_OBS_REPLICATION_AGENCY")
+
+	HW_SFS_TURBO_HPC_SHARE_ID = os.Getenv("HW_SFS_TURBO_HPC_SHARE_ID")
 
 	HW_DEPRECATED_ENVIRONMENT = os.Getenv("HW_DEPRECATED_ENVIRONMENT")
 	HW_INTERNAL_USED          = os.Getenv("HW_INTERNAL_USED")
@@ -59,6 +62,10 @@ var (
 	HW_SMS_SOURCE_SERVER            = os.Getenv("HW_SMS_SOURCE_SERVER")
 	HW_CFW_ENVIRONMENT              = os.Getenv("HW_CFW_ENVIRONMENT")
 
+	HW_SCM_DOMAIN_NAME           = os.Getenv("HW_SCM_DOMAIN_NAME")
+	HW_SCM_DNS_ZONE_ID           = os.Getenv("HW_SCM_DNS_ZONE_ID")
+	HW_SCM_ISSUED_CERTIFICATE_ID = os.Getenv("HW_SCM_ISSUED_CERTIFICATE_ID")
+
 	HW_DLI_FLINK_JAR_OBS_PATH = os.Getenv("HW_DLI_FLINK_JAR_OBS_PATH")
 
 	HW_GITHUB_REPO_HOST      = os.Getenv("HW_GITHUB_REPO_HOST")      // Repository host (Github, Gitlab, Gitee)
@@ -94,6 +101,15 @@ var (
 	HW_KMS_ENVIRONMENT = os.Getenv("HW_KMS_ENVIRONMENT")
 
 	HW_ER_TEST_ON = os.Getenv("HW_ER_TEST_ON") // Whether to run the ER related tests.
+	// The ID of the ER instance that is shared with other accounts.
+	HW_ER_INSTANCE_ID = os.Getenv("HW_ER_INSTANCE_ID")
+	// The ID of the attachment created by other account through the shared ER instance.
+	HW_ER_SHARED_ATTACHMENT_ID = os.Getenv("HW_ER_SHARED_ATTACHMENT_ID")
+
+	// The ID of the account that the resources are shared with.
+	HW_RAM_SHARE_ACCOUNT_ID = os.Getenv("HW_RAM_SHARE_ACCOUNT_ID")
+	// The ID of the resource share (in pending status) that is shared with the current account.
+	HW_RAM_SHARE_INVITATION_SHARE_ID = os.Getenv("HW_RAM_SHARE_INVITATION_SHARE_ID")
 
 	// The OBS address where the HCL/JSON template archive (No variables) is located.
 	HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI = os.Getenv("HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI")
@@ -275,6 +291,20 @@ func TestAccPreCheckOBSBucket(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckOBSReplication(t *testing.T) {
+	if HW_DEST_REGION == "" || HW_OBS_REPLICATION_AGENCY == "" {
+		t.Skip("HW_DEST_REGION and HW_OBS_REPLICATION_AGENCY must be set for OBS replication acceptance tests")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckSfsTurboHpcShareId(t *testing.T) {
+	if HW_SFS_TURBO_HPC_SHARE_ID == "" {
+		t.Skip("HW_SFS_TURBO_HPC_SHARE_ID must be set for SFS Turbo OBS target acceptance tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckChargingMode(t *testing.T) {
 	if HW_CHARGING_MODE != "prePaid" {
@@ -300,6 +330,21 @@ func TestAccPreCheckScm(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckScmManagedCertificate(t *testing.T) {
+	if HW_SCM_DOMAIN_NAME == "" || HW_SCM_DNS_ZONE_ID == "" {
+		t.Skip("HW_SCM_DOMAIN_NAME and HW_SCM_DNS_ZONE_ID must be set for SCM managed certificate tests, " +
+			"the domain name must belong to the public DNS zone")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckScmCertificateDeployment(t *testing.T) {
+	if HW_SCM_ISSUED_CERTIFICATE_ID == "" {
+		t.Skip("HW_SCM_ISSUED_CERTIFICATE_ID must be set for SCM certificate deployment tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckSWRDomian(t *testing.T) {
 	if HW_SWR_SHARING_ACCOUNT == "" {
@@ -438,6 +483,35 @@ func TestAccPreCheckER(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckERPeering(t *testing.T) {
+	if HW_DEST_REGION == "" {
+		t.Skip("HW_DEST_REGION must be set for the ER peering attachment acceptance test.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckERSharedAttachment(t *testing.T) {
+	if HW_ER_INSTANCE_ID == "" || HW_ER_SHARED_ATTACHMENT_ID == "" {
+		t.Skip("HW_ER_INSTANCE_ID and HW_ER_SHARED_ATTACHMENT_ID must be set for the ER attachment accepter " +
+			"acceptance test.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckRAMShareAccount(t *testing.T) {
+	if HW_RAM_SHARE_ACCOUNT_ID == "" {
+		t.Skip("HW_RAM_SHARE_ACCOUNT_ID must be set for the RAM resource share acceptance test.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckRAMShareInvitation(t *testing.T) {
+	if HW_RAM_SHARE_INVITATION_SHARE_ID == "" {
+		t.Skip("HW_RAM_SHARE_INVITATION_SHARE_ID must be set for the RAM resource share accepter acceptance test.")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckRfArchives(t *testing.T) {
 	if HW_RF_TEMPLATE_ARCHIVE_NO_VARS_URI == "" || HW_RF_TEMPLATE_ARCHIVE_URI == "" ||
@@ -0,0 +1,54 @@
+package cbr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataBackups_basic(t *testing.T) {
+	randName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_cbr_backups.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataBackups_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backups.0.id",
+						"huaweicloud_cbr_backup.test", "backups.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backups.0.resource_id",
+						"huaweicloud_evs_volume.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.resource_type", "OS::Cinder::Volume"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.status", "available"),
+					resource.TestCheckResourceAttr("data.huaweicloud_cbr_backups.replicated", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataBackups_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_cbr_backups" "test" {
+  checkpoint_id = huaweicloud_cbr_backup.test.id
+  resource_id   = huaweicloud_evs_volume.test.id
+  resource_type = "OS::Cinder::Volume"
+}
+
+data "huaweicloud_cbr_backups" "replicated" {
+  checkpoint_id      = huaweicloud_cbr_backup.test.id
+  destination_region = "%s"
+}
+`, testAccBackup_basic(rName), acceptance.HW_REGION_NAME)
+}
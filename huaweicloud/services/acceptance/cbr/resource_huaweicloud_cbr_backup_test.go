@@ -0,0 +1,109 @@
+package cbr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cbr"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getBackupResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CbrV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CBR v3 client: %s", err)
+	}
+	checkpoint, err := cbr.GetCheckpoint(c, state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	if utils.PathSearch("status", checkpoint, "").(string) == "deleted" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return checkpoint, nil
+}
+
+func TestAccBackup_basic(t *testing.T) {
+	var checkpoint interface{}
+	randName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_cbr_backup.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&checkpoint,
+		getBackupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackup_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vault_id", "huaweicloud_cbr_vault.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resources.*", "huaweicloud_evs_volume.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "backups.0.resource_id",
+						"huaweicloud_evs_volume.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "backups.0.status", "available"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"incremental"},
+			},
+		},
+	})
+}
+
+func testAccBackup_base(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_evs_volume" "test" {
+  name              = "%[1]s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  volume_type       = "SSD"
+  size              = 10
+}
+
+resource "huaweicloud_cbr_vault" "test" {
+  name            = "%[1]s"
+  type            = "disk"
+  protection_type = "backup"
+  size            = 50
+
+  resources {
+    includes = [huaweicloud_evs_volume.test.id]
+  }
+}
+`, rName)
+}
+
+func testAccBackup_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cbr_backup" "test" {
+  vault_id    = huaweicloud_cbr_vault.test.id
+  name        = "%[2]s"
+  description = "Created by acceptance test"
+  resources   = [huaweicloud_evs_volume.test.id]
+}
+`, testAccBackup_base(rName), rName)
+}
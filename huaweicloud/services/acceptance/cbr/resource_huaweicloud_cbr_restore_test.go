@@ -0,0 +1,43 @@
+package cbr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRestore_volume(t *testing.T) {
+	randName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_cbr_restore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestore_volume(randName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "backup_id",
+						"huaweicloud_cbr_backup.test", "backups.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id",
+						"huaweicloud_evs_volume.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRestore_volume(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cbr_restore" "test" {
+  backup_id = huaweicloud_cbr_backup.test.backups[0].id
+  volume_id = huaweicloud_evs_volume.test.id
+}
+`, testAccBackup_basic(rName))
+}
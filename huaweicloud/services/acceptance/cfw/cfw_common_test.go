@@ -0,0 +1,50 @@
+package cfw
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// requestCfwResource sends the GET request to the CFW API, the httpUrl contains the query parameters.
+func requestCfwResource(cfg *config.Config, httpUrl string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("cfw", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CFW client: %s", err)
+	}
+
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// queryCfwRecord queries the record whose key equals to the ID from the CFW list API.
+func queryCfwRecord(cfg *config.Config, httpUrl, key, id string) (interface{}, error) {
+	respBody, err := requestCfwResource(cfg, httpUrl+"&limit=1024&offset=0")
+	if err != nil {
+		return nil, err
+	}
+
+	records := utils.PathSearch("data.records", respBody, make([]interface{}, 0)).([]interface{})
+	for _, record := range records {
+		if utils.PathSearch(key, record, "").(string) == id {
+			return record, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
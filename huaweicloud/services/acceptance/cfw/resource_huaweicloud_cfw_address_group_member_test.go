@@ -0,0 +1,70 @@
+package cfw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getAddressGroupMemberResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	httpUrl := fmt.Sprintf("v1/{project_id}/address-items?set_id=%s", state.Primary.Attributes["group_id"])
+	return queryCfwRecord(cfg, httpUrl, "item_id", state.Primary.ID)
+}
+
+func TestAccAddressGroupMember_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cfw_address_group_member.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getAddressGroupMemberResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAddressGroupMember_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "group_id", "huaweicloud_cfw_address_group.test", "id"),
+					resource.TestCheckResourceAttr(rName, "address", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "terraform test"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testCfwParentImportState(rName, "group_id"),
+			},
+		},
+	})
+}
+
+func testAddressGroupMember_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_address_group_member" "test" {
+  group_id    = huaweicloud_cfw_address_group.test.id
+  address     = "192.168.0.0/24"
+  name        = "%s"
+  description = "terraform test"
+}
+`, testAddressGroup_basic(name, ""), name)
+}
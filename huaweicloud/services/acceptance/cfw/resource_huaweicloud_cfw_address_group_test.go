@@ -0,0 +1,102 @@
+package cfw
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getAddressGroupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	httpUrl := strings.ReplaceAll("v1/{project_id}/address-sets/{id}", "{id}", state.Primary.ID)
+	respBody, err := requestCfwResource(cfg, httpUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	group := utils.PathSearch("data", respBody, nil)
+	if group == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return group, nil
+}
+
+func TestAccAddressGroup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cfw_address_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getAddressGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAddressGroup_basic(name, "terraform test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "address_type", "0"),
+					resource.TestCheckResourceAttr(rName, "description", "terraform test"),
+				),
+			},
+			{
+				Config: testAddressGroup_basic(name+"-update", ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testCfwParentImportState(rName, "object_id"),
+			},
+		},
+	})
+}
+
+func testAddressGroup_basic(name, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_address_group" "test" {
+  object_id   = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+  name        = "%s"
+  description = "%s"
+}
+`, testAccDatasourceFirewalls_basic(), name, description)
+}
+
+func testCfwParentImportState(name, parentKey string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		if rs.Primary.Attributes[parentKey] == "" || rs.Primary.ID == "" {
+			return "", fmt.Errorf("the %s or ID of the resource (%s) is empty", parentKey, name)
+		}
+
+		return rs.Primary.Attributes[parentKey] + "/" + rs.Primary.ID, nil
+	}
+}
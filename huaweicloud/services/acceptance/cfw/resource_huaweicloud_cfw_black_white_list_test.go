@@ -0,0 +1,111 @@
+package cfw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getBlackWhiteListResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	httpUrl := fmt.Sprintf("v1/{project_id}/black-white-lists?object_id=%s&list_type=%s",
+		state.Primary.Attributes["object_id"], state.Primary.Attributes["list_type"])
+	return queryCfwRecord(cfg, httpUrl, "list_id", state.Primary.ID)
+}
+
+func TestAccBlackWhiteList_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := "huaweicloud_cfw_black_white_list.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBlackWhiteListResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testBlackWhiteList_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "list_type", "4"),
+					resource.TestCheckResourceAttr(rName, "direction", "0"),
+					resource.TestCheckResourceAttr(rName, "address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(rName, "protocol", "6"),
+					resource.TestCheckResourceAttr(rName, "port", "22"),
+					resource.TestCheckResourceAttr(rName, "description", "terraform test"),
+				),
+			},
+			{
+				Config: testBlackWhiteList_update(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "direction", "1"),
+					resource.TestCheckResourceAttr(rName, "address", "1.1.1.0/24"),
+					resource.TestCheckResourceAttr(rName, "protocol", "-1"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testBlackWhiteListImportState(rName),
+			},
+		},
+	})
+}
+
+func testBlackWhiteList_basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_black_white_list" "test" {
+  object_id   = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+  list_type   = 4
+  direction   = 0
+  address     = "1.1.1.1"
+  protocol    = 6
+  port        = "22"
+  description = "terraform test"
+}
+`, testAccDatasourceFirewalls_basic())
+}
+
+func testBlackWhiteList_update() string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_black_white_list" "test" {
+  object_id = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+  list_type = 4
+  direction = 1
+  address   = "1.1.1.0/24"
+  protocol  = -1
+}
+`, testAccDatasourceFirewalls_basic())
+}
+
+func testBlackWhiteListImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["object_id"], rs.Primary.Attributes["list_type"],
+			rs.Primary.ID), nil
+	}
+}
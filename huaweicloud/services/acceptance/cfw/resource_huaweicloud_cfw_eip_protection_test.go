@@ -0,0 +1,89 @@
+package cfw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getEipProtectionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	httpUrl := fmt.Sprintf("v1/{project_id}/eips/protect?object_id=%s", state.Primary.ID)
+	record, err := queryCfwRecord(cfg, httpUrl, "id", state.Primary.Attributes["protected_eip.0.id"])
+	if err != nil {
+		return nil, err
+	}
+	// The status 0 means the EIP is protected.
+	if utils.PathSearch("status", record, float64(1)).(float64) != 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return record, nil
+}
+
+func TestAccEipProtection_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cfw_eip_protection.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getEipProtectionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testEipProtection_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "protected_eip.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "protected_eip.0.id", "huaweicloud_vpc_eip.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "protected_eip.0.public_ip",
+						"huaweicloud_vpc_eip.test", "address"),
+				),
+			},
+		},
+	})
+}
+
+func testEipProtection_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+
+  bandwidth {
+    name        = "%s"
+    size        = 5
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+}
+
+resource "huaweicloud_cfw_eip_protection" "test" {
+  object_id = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+
+  protected_eip {
+    id        = huaweicloud_vpc_eip.test.id
+    public_ip = huaweicloud_vpc_eip.test.address
+  }
+}
+`, testAccDatasourceFirewalls_basic(), name)
+}
@@ -0,0 +1,101 @@
+package cfw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getIpsAntivirusConfigResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return requestCfwResource(cfg, fmt.Sprintf("v1/{project_id}/ips/protect?object_id=%s", state.Primary.ID))
+}
+
+func TestAccIpsAntivirusConfig_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := "huaweicloud_cfw_ips_antivirus_config.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getIpsAntivirusConfigResourceFunc,
+	)
+
+	// The configurations are kept after the resource is destroyed, so the destroy is not checked.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testIpsAntivirusConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "ips_protection_mode", "1"),
+					resource.TestCheckResourceAttr(rName, "basic_defense_enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "virtual_patches_enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "antivirus_enabled", "true"),
+				),
+			},
+			{
+				Config: testIpsAntivirusConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "ips_protection_mode", "0"),
+					resource.TestCheckResourceAttr(rName, "virtual_patches_enabled", "false"),
+					resource.TestCheckResourceAttr(rName, "antivirus_protocol_configs.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIpsAntivirusConfig_basic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_ips_antivirus_config" "test" {
+  object_id               = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+  ips_protection_mode     = 1
+  basic_defense_enabled   = true
+  virtual_patches_enabled = true
+  antivirus_enabled       = true
+}
+`, testAccDatasourceFirewalls_basic())
+}
+
+func testIpsAntivirusConfig_update() string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_ips_antivirus_config" "test" {
+  object_id               = data.huaweicloud_cfw_firewalls.test.records[0].protect_objects[0].object_id
+  ips_protection_mode     = 0
+  basic_defense_enabled   = true
+  virtual_patches_enabled = false
+  antivirus_enabled       = true
+
+  antivirus_protocol_configs {
+    protocol_type = 0
+    action        = 1
+  }
+
+  antivirus_protocol_configs {
+    protocol_type = 4
+    action        = 0
+  }
+}
+`, testAccDatasourceFirewalls_basic())
+}
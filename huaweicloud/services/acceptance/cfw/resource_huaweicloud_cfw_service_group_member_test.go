@@ -0,0 +1,72 @@
+package cfw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getServiceGroupMemberResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	httpUrl := fmt.Sprintf("v1/{project_id}/service-items?set_id=%s", state.Primary.Attributes["group_id"])
+	return queryCfwRecord(cfg, httpUrl, "item_id", state.Primary.ID)
+}
+
+func TestAccServiceGroupMember_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_cfw_service_group_member.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getServiceGroupMemberResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCfw(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceGroupMember_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "group_id", "huaweicloud_cfw_service_group.test", "id"),
+					resource.TestCheckResourceAttr(rName, "protocol", "6"),
+					resource.TestCheckResourceAttr(rName, "source_port", "1-65535"),
+					resource.TestCheckResourceAttr(rName, "dest_port", "443"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testCfwParentImportState(rName, "group_id"),
+			},
+		},
+	})
+}
+
+func testServiceGroupMember_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cfw_service_group_member" "test" {
+  group_id    = huaweicloud_cfw_service_group.test.id
+  protocol    = 6
+  source_port = "1-65535"
+  dest_port   = "443"
+  name        = "%s"
+}
+`, testServiceGroup_basic(name, ""), name)
+}
@@ -0,0 +1,52 @@
+package cts
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceCTSTraces_basic(t *testing.T) {
+	var (
+		rName          = acceptance.RandomAccResourceName()
+		dataSourceName = "data.huaweicloud_cts_traces.test"
+		dc             = acceptance.InitDataSourceCheck(dataSourceName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCTSTraces_basic(rName, time.Now().Add(-time.Hour).Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestMatchResourceAttr(dataSourceName, "traces.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "traces.0.service_type", "SMN"),
+					resource.TestCheckResourceAttr(dataSourceName, "traces.0.resource_name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "traces.0.user"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "traces.0.time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCTSTraces_basic(rName, from string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+
+data "huaweicloud_cts_traces" "test" {
+  from          = "%[2]s"
+  service_type  = "SMN"
+  resource_name = huaweicloud_smn_topic.test.name
+}
+`, rName, from)
+}
@@ -2,6 +2,7 @@ package cts
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -87,14 +88,45 @@ func TestAccCTSNotification_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_operations"},
 			},
 		},
 	})
 }
 
+func TestAccCTSNotification_invalidOperation(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCTSNotification_invalidOperation(rName),
+				ExpectError: regexp.MustCompile("is not a key operation"),
+			},
+		},
+	})
+}
+
+func testAccCTSNotification_invalidOperation(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_cts_notification" "notify" {
+  name           = "%[1]s"
+  operation_type = "customized"
+
+  operations {
+    service     = "ECS"
+    resource    = "ecs"
+    trace_names = ["deleteServers", "notAnOperation"]
+  }
+}
+`, rName)
+}
+
 func testAccCTSNotification_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_smn_topic" "topic_1" {
@@ -2,6 +2,7 @@ package cts
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -52,6 +53,24 @@ func TestAccCTSTracker_basic(t *testing.T) {
 	})
 }
 
+func TestAccCTSTracker_bucketPolicyDenied(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCTSTracker_bucketPolicyDenied_base(rName),
+			},
+			{
+				Config:      testAccCTSTracker_bucketPolicyDenied(rName),
+				ExpectError: regexp.MustCompile("CTS can not write the trace files to the OBS bucket"),
+			},
+		},
+	})
+}
+
 func testAccCTSTrackerImportState(name string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		tracker, ok := s.RootModule().Resources[name]
@@ -139,3 +158,42 @@ resource "huaweicloud_cts_tracker" "tracker" {
 }
 `, rName)
 }
+
+func testAccCTSTracker_bucketPolicyDenied_base(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "bucket" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_obs_bucket_policy" "policy" {
+  bucket = huaweicloud_obs_bucket.bucket.bucket
+  policy = <<POLICY
+{
+  "Statement": [
+    {
+      "Sid": "DenyPutObject",
+      "Effect": "Deny",
+      "Principal": {"ID": ["*"]},
+      "Action": ["PutObject"],
+      "Resource": ["%[1]s/*"]
+    }
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
+// The bucket name is specified directly, so that it is known and validated during the plan.
+func testAccCTSTracker_bucketPolicyDenied(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cts_tracker" "tracker" {
+  bucket_name = "%[2]s"
+  file_prefix = "cts"
+}
+`, testAccCTSTracker_bucketPolicyDenied_base(rName), rName)
+}
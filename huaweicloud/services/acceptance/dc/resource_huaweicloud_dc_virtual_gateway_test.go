@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/chnsz/golangsdk/openstack/dc/v3/gateways"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -111,3 +112,77 @@ resource "huaweicloud_dc_virtual_gateway" "test" {
 }
 `, name, cidr)
 }
+
+func TestAccVirtualGateway_enterpriseRouter(t *testing.T) {
+	var (
+		gateway gateways.VirtualGateway
+
+		rName    = "huaweicloud_dc_virtual_gateway.test"
+		name     = acceptance.RandomAccResourceName()
+		cidr     = acceptance.RandomCidr()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&gateway,
+		getVirtualGatewayFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualGateway_enterpriseRouter(name, cidr, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "enterprise_router_id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "vpc_id", ""),
+					resource.TestCheckResourceAttr(rName, "local_ep_group.0", cidr),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttr("data.huaweicloud_er_attachments.test", "attachments.#", "1"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVirtualGateway_enterpriseRouter(name, cidr string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["%[1]s"]
+
+  name = "%[2]s"
+  asn  = %[4]d
+}
+
+resource "huaweicloud_dc_virtual_gateway" "test" {
+  enterprise_router_id = huaweicloud_er_instance.test.id
+  name                 = "%[2]s"
+
+  local_ep_group = [
+    "%[3]s",
+  ]
+}
+
+data "huaweicloud_er_attachments" "test" {
+  depends_on = [
+    huaweicloud_dc_virtual_gateway.test
+  ]
+
+  instance_id = huaweicloud_er_instance.test.id
+  type        = "vgw"
+}
+`, acceptance.HW_AVAILABILITY_ZONE, name, cidr, bgpAsNum)
+}
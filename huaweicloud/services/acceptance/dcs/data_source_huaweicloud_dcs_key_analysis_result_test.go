@@ -0,0 +1,53 @@
+package dcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceDcsKeyAnalysisResult_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_dcs_key_analysis_result.test"
+	latestName := "data.huaweicloud_dcs_key_analysis_result.latest"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDcsKeyAnalysisResult_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "task_id",
+						"huaweicloud_dcs_bigkey_analysis.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "success"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "scan_type"),
+					resource.TestCheckResourceAttrPair(latestName, "task_id",
+						"huaweicloud_dcs_hotkey_analysis.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDcsKeyAnalysisResult_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dcs_key_analysis_result" "test" {
+  instance_id = huaweicloud_dcs_instance.test.id
+  type        = "bigkey"
+  task_id     = huaweicloud_dcs_bigkey_analysis.test.id
+}
+
+data "huaweicloud_dcs_key_analysis_result" "latest" {
+  depends_on = [huaweicloud_dcs_hotkey_analysis.test]
+
+  instance_id = huaweicloud_dcs_instance.test.id
+  type        = "hotkey"
+}
+`, testAccDcsKeyAnalysis_basic(name))
+}
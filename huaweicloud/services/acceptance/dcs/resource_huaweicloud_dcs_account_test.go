@@ -0,0 +1,136 @@
+package dcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDcsAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DcsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DCS client: %s", err)
+	}
+
+	getPath := client.Endpoint + fmt.Sprintf("v2/%s/instances/%s/accounts", client.ProjectID,
+		state.Primary.Attributes["instance_id"])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	account := utils.PathSearch(fmt.Sprintf("accounts[?account_id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if account == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return account, nil
+}
+
+func TestAccDcsAccount_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDcsAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsAccount_basic(name, "read", "Huawei_test1"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_dcs_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "account_name", "tf_acc_user"),
+					resource.TestCheckResourceAttr(rName, "account_role", "read"),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+				),
+			},
+			{
+				Config: testAccDcsAccount_basic(name, "write", "Huawei_test2"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "account_role", "write"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccDcsSubResourceImportStateIdFunc(rName),
+				ImportStateVerifyIgnore: []string{"account_password"},
+			},
+		},
+	})
+}
+
+func testAccDcsSubResourceImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDcsRedis6Instance_base(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_vpc" "test" {
+  name = "vpc-default"
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "huaweicloud_dcs_instance" "test" {
+  name               = "%s"
+  engine_version     = "6.0"
+  password           = "Huawei_test"
+  engine             = "Redis"
+  capacity           = 0.125
+  vpc_id             = data.huaweicloud_vpc.test.id
+  subnet_id          = data.huaweicloud_vpc_subnet.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor             = "redis.ha.xu1.tiny.r2.128"
+}
+`, name)
+}
+
+func testAccDcsAccount_basic(name, role, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_account" "test" {
+  instance_id      = huaweicloud_dcs_instance.test.id
+  account_name     = "tf_acc_user"
+  account_password = "%s"
+  account_role     = "%s"
+  description      = "created by terraform"
+}
+`, testAccDcsRedis6Instance_base(name), password, role)
+}
@@ -79,6 +79,85 @@ func TestAccDcsInstances_basic(t *testing.T) {
 	})
 }
 
+func TestAccDcsInstances_upgrade(t *testing.T) {
+	var instance instances.DcsInstance
+	var instanceName = acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dcs_instance.instance_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDcsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsInstance_upgrade(instanceName, "5.0", "redis.single.xu1.tiny.128"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "5.0"),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.single.xu1.tiny.128"),
+				),
+			},
+			{
+				Config: testAccDcsInstance_upgrade(instanceName, "6.0", "redis.ha.xu1.tiny.r2.128"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "6.0"),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.ha.xu1.tiny.r2.128"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDcsInstances_changeType(t *testing.T) {
+	var instance instances.DcsInstance
+	var instanceName = acceptance.RandomAccResourceName()
+	var instanceId string
+	resourceName := "huaweicloud_dcs_instance.instance_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDcsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsInstance_upgrade(instanceName, "5.0", "redis.single.xu1.tiny.128"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.single.xu1.tiny.128"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						instanceId = value
+						return nil
+					}),
+				),
+			},
+			{
+				// The instance type is changed from single to ha in place.
+				Config: testAccDcsInstance_upgrade(instanceName, "5.0", "redis.ha.xu1.tiny.r2.128"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instanceId),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.ha.xu1.tiny.r2.128"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDcsInstances_withEpsId(t *testing.T) {
 	var instance instances.DcsInstance
 	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
@@ -286,6 +365,31 @@ resource "huaweicloud_dcs_instance" "instance_1" {
 }`, instanceName)
 }
 
+func testAccDcsInstance_upgrade(instanceName, engineVersion, flavor string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_vpc" "test" {
+  name = "vpc-default"
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "huaweicloud_dcs_instance" "instance_1" {
+  name               = "%s"
+  engine_version     = "%s"
+  password           = "Huawei_test"
+  engine             = "Redis"
+  capacity           = 0.125
+  vpc_id             = data.huaweicloud_vpc.test.id
+  subnet_id          = data.huaweicloud_vpc_subnet.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor             = "%s"
+}`, instanceName, engineVersion, flavor)
+}
+
 func testAccDcsV1Instance_updated(instanceName string) string {
 	return fmt.Sprintf(`
 data "huaweicloud_availability_zones" "test" {}
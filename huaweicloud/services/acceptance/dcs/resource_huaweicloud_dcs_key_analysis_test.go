@@ -0,0 +1,87 @@
+package dcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDcsKeyAnalysisResourceFunc(analysisType string) func(*config.Config, *terraform.ResourceState) (interface{}, error) {
+	return func(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+		client, err := cfg.DcsV2Client(acceptance.HW_REGION_NAME)
+		if err != nil {
+			return nil, fmt.Errorf("error creating DCS client: %s", err)
+		}
+
+		getPath := client.Endpoint + fmt.Sprintf("v2/%s/instances/%s/%s-task/%s", client.ProjectID,
+			state.Primary.Attributes["instance_id"], analysisType, state.Primary.ID)
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		}
+		resp, err := client.Request("GET", getPath, &getOpt)
+		if err != nil {
+			return nil, err
+		}
+		return utils.FlattenResponse(resp)
+	}
+}
+
+func TestAccDcsKeyAnalysis_basic(t *testing.T) {
+	var hotKey, bigKey interface{}
+	name := acceptance.RandomAccResourceName()
+	hotKeyName := "huaweicloud_dcs_hotkey_analysis.test"
+	bigKeyName := "huaweicloud_dcs_bigkey_analysis.test"
+
+	rcHotKey := acceptance.InitResourceCheck(hotKeyName, &hotKey, getDcsKeyAnalysisResourceFunc("hotkey"))
+	rcBigKey := acceptance.InitResourceCheck(bigKeyName, &bigKey, getDcsKeyAnalysisResourceFunc("bigkey"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			rcHotKey.CheckResourceDestroy(),
+			rcBigKey.CheckResourceDestroy(),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsKeyAnalysis_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rcHotKey.CheckResourceExists(),
+					rcBigKey.CheckResourceExists(),
+					resource.TestCheckResourceAttr(hotKeyName, "status", "success"),
+					resource.TestCheckResourceAttr(bigKeyName, "status", "success"),
+					resource.TestCheckResourceAttrSet(bigKeyName, "finished_at"),
+				),
+			},
+			{
+				ResourceName:      hotKeyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDcsSubResourceImportStateIdFunc(hotKeyName),
+			},
+		},
+	})
+}
+
+func testAccDcsKeyAnalysis_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_hotkey_analysis" "test" {
+  instance_id = huaweicloud_dcs_instance.test.id
+}
+
+resource "huaweicloud_dcs_bigkey_analysis" "test" {
+  instance_id = huaweicloud_dcs_instance.test.id
+}
+`, testAccDcsRedis6Instance_base(name))
+}
@@ -0,0 +1,107 @@
+package dds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDdsBackupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("backups") + fmt.Sprintf("?instance_id=%s&backup_id=%s",
+		state.Primary.Attributes["instance_id"], state.Primary.ID)
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	backup := utils.PathSearch(fmt.Sprintf("backups[?id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if backup == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return backup, nil
+}
+
+func TestAccDdsBackup_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dds_backup.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDdsBackupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdsBackup_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "huaweicloud_dds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Manual"),
+					resource.TestCheckResourceAttrSet(resourceName, "begin_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDdsBackupImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccDdsBackupImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		instanceId := rs.Primary.Attributes["instance_id"]
+		if instanceId == "" || rs.Primary.ID == "" {
+			return "", fmt.Errorf("invalid format specified for import ID, want '<instance_id>/<backup_id>', but '%s/%s'",
+				instanceId, rs.Primary.ID)
+		}
+		return fmt.Sprintf("%s/%s", instanceId, rs.Primary.ID), nil
+	}
+}
+
+func testAccDdsBackup_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dds_backup" "test" {
+  instance_id = huaweicloud_dds_instance.test.id
+  name        = "%[2]s"
+  description = "created by acc test"
+}
+`, testAccDatabaseRole_base(rName), rName)
+}
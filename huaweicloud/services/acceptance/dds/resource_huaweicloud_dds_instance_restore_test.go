@@ -0,0 +1,80 @@
+package dds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDdsInstanceRestore_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dds_instance_restore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdsInstanceRestore_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "huaweicloud_dds_instance.target", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_id", "huaweicloud_dds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_id", "huaweicloud_dds_backup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDdsInstanceRestore_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dds_instance" "target" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  vpc_id            = huaweicloud_vpc.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  name     = "%[2]s_target"
+  mode     = "Sharding"
+  password = "Test@12345678"
+
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+
+  flavor {
+    type      = "mongos"
+    num       = 2
+    spec_code = "dds.mongodb.c6.large.2.mongos"
+  }
+  flavor {
+    type      = "shard"
+    num       = 2
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "dds.mongodb.c6.large.2.shard"
+  }
+  flavor {
+    type      = "config"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "dds.mongodb.c6.large.2.config"
+  }
+}
+
+resource "huaweicloud_dds_instance_restore" "test" {
+  target_id = huaweicloud_dds_instance.target.id
+  source_id = huaweicloud_dds_instance.test.id
+  backup_id = huaweicloud_dds_backup.test.id
+}
+`, testAccDdsBackup_basic(rName), rName)
+}
@@ -149,6 +149,50 @@ func TestAccDDSV3Instance_withEpsId(t *testing.T) {
 	})
 }
 
+func TestAccDDSV3Instance_topology(t *testing.T) {
+	var instance instances.InstanceResponse
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dds_instance.instance"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDdsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSInstanceV3Config_topology(rName, 3, 3, "dds.mongodb.c6.large.2"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					testAccCheckDDSV3InstanceFlavor(&instance, "mongos", "num", 3),
+					testAccCheckDDSV3InstanceFlavor(&instance, "shard", "num", 3),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_topology(rName, 2, 2, "dds.mongodb.c6.large.2"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					testAccCheckDDSV3InstanceFlavor(&instance, "mongos", "num", 2),
+					testAccCheckDDSV3InstanceFlavor(&instance, "shard", "num", 2),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_topology(rName, 2, 2, "dds.mongodb.c6.large.4"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					testAccCheckDDSV3InstanceFlavor(&instance, "shard", "spec_code", "dds.mongodb.c6.large.4.shard"),
+					testAccCheckDDSV3InstanceFlavor(&instance, "config", "spec_code", "dds.mongodb.c6.large.4.config"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDDSV3Instance_prePaid(t *testing.T) {
 	var instance instances.InstanceResponse
 	rName := acceptance.RandomAccResourceName()
@@ -529,6 +573,47 @@ resource "huaweicloud_dds_instance" "instance" {
 }`, testAccDDSInstanceV3Config_Base(rName), rName)
 }
 
+func testAccDDSInstanceV3Config_topology(rName string, mongosNum, shardNum int, specPrefix string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dds_instance" "instance" {
+  name              = "%[2]s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  vpc_id            = data.huaweicloud_vpc.test.id
+  subnet_id         = data.huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.secgroup_acc.id
+  password          = "Terraform@123"
+  mode              = "Sharding"
+
+  datastore {
+    type           = "DDS-Community"
+    version        = "4.0"
+    storage_engine = "wiredTiger"
+  }
+
+  flavor {
+    type      = "mongos"
+    num       = %[3]d
+    spec_code = "dds.mongodb.c6.large.2.mongos"
+  }
+  flavor {
+    type      = "shard"
+    num       = %[4]d
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "%[5]s.shard"
+  }
+  flavor {
+    type      = "config"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "%[5]s.config"
+  }
+}`, testAccDDSInstanceV3Config_Base(rName), rName, mongosNum, shardNum, specPrefix)
+}
+
 func testAccDDSInstanceV3Config_withEpsId(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,43 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccKmsDecryptedDataDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_kms_decrypted_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsDecryptedDataDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "plain_text", "this is a secret"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_id", "huaweicloud_kms_key.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsDecryptedDataDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_kms_decrypted_data" "test" {
+  cipher_text = data.huaweicloud_kms_encrypted_data.test.cipher_text
+
+  encryption_context = {
+    purpose = "test"
+  }
+}
+`, testAccKmsEncryptedDataDataSource_basic(rName))
+}
@@ -0,0 +1,46 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccKmsEncryptedDataDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_kms_encrypted_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsEncryptedDataDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "cipher_text"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsEncryptedDataDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key" "test" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+data "huaweicloud_kms_encrypted_data" "test" {
+  key_id     = huaweicloud_kms_key.test.id
+  plain_text = "this is a secret"
+
+  encryption_context = {
+    purpose = "test"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,46 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccKmsSignatureDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_kms_signature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsSignatureDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("huaweicloud_kms_key.test", "key_usage", "SIGN_VERIFY"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "signature"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSignatureDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key" "test" {
+  key_alias     = "%s"
+  key_algorithm = "RSA_2048"
+  key_usage     = "SIGN_VERIFY"
+  pending_days  = "7"
+}
+
+data "huaweicloud_kms_signature" "test" {
+  key_id            = huaweicloud_kms_key.test.id
+  message           = base64encode("this is a message")
+  signing_algorithm = "RSASSA_PKCS1_V1_5_SHA_256"
+}
+`, rName)
+}
@@ -0,0 +1,50 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccKmsSignatureVerificationDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsSignatureVerificationDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.huaweicloud_kms_signature_verification.valid",
+						"signature_valid", "true"),
+					resource.TestCheckResourceAttr("data.huaweicloud_kms_signature_verification.invalid",
+						"signature_valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSignatureVerificationDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_kms_signature_verification" "valid" {
+  key_id            = huaweicloud_kms_key.test.id
+  message           = base64encode("this is a message")
+  signature         = data.huaweicloud_kms_signature.test.signature
+  signing_algorithm = "RSASSA_PKCS1_V1_5_SHA_256"
+}
+
+data "huaweicloud_kms_signature_verification" "invalid" {
+  key_id            = huaweicloud_kms_key.test.id
+  message           = base64encode("this is another message")
+  signature         = data.huaweicloud_kms_signature.test.signature
+  signing_algorithm = "RSASSA_PKCS1_V1_5_SHA_256"
+}
+`, testAccKmsSignatureDataSource_basic(rName))
+}
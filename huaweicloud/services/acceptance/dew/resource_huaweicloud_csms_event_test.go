@@ -0,0 +1,87 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getCsmsEventFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.KmsV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	return client.Request("GET", client.ServiceURL("csms", "events", state.Primary.ID), &getOpt)
+}
+
+func TestAccCsmsEvent_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_csms_event.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCsmsEventFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsEvent_basic(name, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "smn_topic_urn",
+						"huaweicloud_smn_topic.test", "topic_urn"),
+					resource.TestCheckResourceAttrSet(resourceName, "event_id"),
+				),
+			},
+			{
+				Config: testAccCsmsEvent_basic(name, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCsmsEvent_basic(name, status string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_csms_event" "test" {
+  name           = "%[1]s"
+  event_types    = ["SECRET_ROTATED", "SECRET_VERSION_EXPIRED"]
+  smn_topic_urn  = huaweicloud_smn_topic.test.topic_urn
+  smn_topic_name = huaweicloud_smn_topic.test.name
+  status         = "%[2]s"
+}
+`, name, status)
+}
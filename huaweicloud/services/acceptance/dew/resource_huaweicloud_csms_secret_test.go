@@ -110,3 +110,64 @@ resource "huaweicloud_csms_secret" "test" {
 }
 `, name)
 }
+
+func TestAccDewCsmsSecret_rotation(t *testing.T) {
+	var secret secrets.Secret
+	name := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_csms_secret.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&secret,
+		geCsmsSecretFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDewCsmsSecret_rotation(name, true, "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary",
+						utils.HashAndHexEncode("dGhpcyBpcyBhIHBhc3N3b3Jk")),
+					resource.TestCheckResourceAttr(resourceName, "auto_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "30d"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_subscriptions.0",
+						"huaweicloud_csms_event.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_time"),
+				),
+			},
+			{
+				Config: testAccDewCsmsSecret_rotation(name, false, "6h"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "auto_rotation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "6h"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDewCsmsSecret_rotation(name string, autoRotation bool, period string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_csms_secret" "test" {
+  name                = "%[2]s"
+  secret_binary       = "dGhpcyBpcyBhIHBhc3N3b3Jk"
+  auto_rotation       = %[3]t
+  rotation_period     = "%[4]s"
+  event_subscriptions = [huaweicloud_csms_event.test.name]
+}
+`, testAccCsmsEvent_basic(name, "ENABLED"), name, autoRotation, period)
+}
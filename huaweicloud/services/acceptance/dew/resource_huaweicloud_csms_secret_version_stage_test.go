@@ -0,0 +1,88 @@
+package dew
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getCsmsSecretVersionStageFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.KmsV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	getPath := client.ServiceURL("secrets", state.Primary.Attributes["secret_name"], "stages",
+		state.Primary.Attributes["name"])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	return client.Request("GET", getPath, &getOpt)
+}
+
+func TestAccCsmsSecretVersionStage_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_csms_secret_version_stage.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCsmsSecretVersionStageFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCsmsSecretVersionStage_basic(name, "this is a password"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_name",
+						"huaweicloud_csms_secret.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", "CUSTOM"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_id",
+						"huaweicloud_csms_secret.test", "latest_version"),
+				),
+			},
+			{
+				Config: testAccCsmsSecretVersionStage_basic(name, "this is a new password"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "version_id",
+						"huaweicloud_csms_secret.test", "latest_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCsmsSecretVersionStage_basic(name, secretText string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_csms_secret" "test" {
+  name        = "%s"
+  secret_text = "%s"
+}
+
+resource "huaweicloud_csms_secret_version_stage" "test" {
+  secret_name = huaweicloud_csms_secret.test.name
+  name        = "CUSTOM"
+  version_id  = huaweicloud_csms_secret.test.latest_version
+}
+`, name, secretText)
+}
@@ -0,0 +1,106 @@
+package dew
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getKmsGrantFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.KmsKeyV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating KMS client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id": parts[0],
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "list-grants"), &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	grant := utils.PathSearch(fmt.Sprintf("grants[?grant_id=='%s']|[0]", parts[1]), respBody, nil)
+	if grant == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return grant, nil
+}
+
+func TestAccKmsGrant_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_kms_grant.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getKmsGrantFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsGrant_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "huaweicloud_kms_key.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee_principal",
+						"huaweicloud_identity_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "grantee_principal_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "operations.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "creator"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKmsGrant_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key" "test" {
+  key_alias    = "%[1]s"
+  pending_days = "7"
+}
+
+resource "huaweicloud_identity_user" "test" {
+  name     = "%[1]s"
+  password = "password12345!"
+  enabled  = true
+}
+
+resource "huaweicloud_kms_grant" "test" {
+  key_id            = huaweicloud_kms_key.test.id
+  grantee_principal = huaweicloud_identity_user.test.id
+  name              = "%[1]s"
+  operations        = ["encrypt-data", "decrypt-data"]
+}
+`, rName)
+}
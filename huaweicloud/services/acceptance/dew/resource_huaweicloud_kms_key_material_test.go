@@ -0,0 +1,82 @@
+package dew
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/kms/v1/keys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getKmsKeyMaterialFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.KmsKeyV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating KMS client: %s", err)
+	}
+
+	key, err := keys.Get(client, state.Primary.ID).ExtractKeyInfo()
+	if err != nil {
+		return nil, err
+	}
+	// The key is waiting for the key material import or pending deletion.
+	if key.KeyState == "4" || key.KeyState == "5" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return key, nil
+}
+
+func TestAccKmsKeyMaterial_basic(t *testing.T) {
+	var obj keys.Key
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_kms_key_material.test"
+
+	material := make([]byte, 32)
+	if _, err := rand.Read(material); err != nil {
+		t.Fatalf("error generating the key material: %s", err)
+	}
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getKmsKeyMaterialFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKmsKeyMaterial_basic(rName, base64.StdEncoding.EncodeToString(material)),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "huaweicloud_kms_key.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "wrapping_algorithm", "RSAES_OAEP_SHA_256"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsKeyMaterial_basic(rName, material string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_kms_key" "test" {
+  key_alias    = "%s"
+  origin       = "external"
+  pending_days = "7"
+}
+
+resource "huaweicloud_kms_key_material" "test" {
+  key_id       = huaweicloud_kms_key.test.id
+  key_material = "%s"
+}
+`, rName, material)
+}
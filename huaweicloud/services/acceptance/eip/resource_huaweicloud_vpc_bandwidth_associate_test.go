@@ -0,0 +1,138 @@
+package eip
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/bandwidths"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getBandwidthAssociateResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.NetworkingV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format: %s", state.Primary.ID)
+	}
+	b, err := bandwidths.Get(c, parts[0]).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, publicIP := range b.PublicipInfo {
+		if publicIP.PublicipId == parts[1] {
+			return publicIP, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccBandWidthAssociate_ipv6Port(t *testing.T) {
+	var (
+		obj bandwidths.PublicIpinfo
+
+		rName = "huaweicloud_vpc_bandwidth_associate.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBandwidthAssociateResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBandWidthAssociate_ipv6Port(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "bandwidth_id", "huaweicloud_vpc_bandwidth.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "port_id",
+						"huaweicloud_compute_instance.test", "network.0.port"),
+					resource.TestCheckResourceAttrPair(rName, "ip_address",
+						"huaweicloud_compute_instance.test", "network.0.fixed_ip_v6"),
+					resource.TestCheckResourceAttr(rName, "bandwidth_name", name),
+					resource.TestCheckResourceAttr(rName, "ip_version", "6"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bandwidth_charge_mode", "bandwidth_size"},
+			},
+		},
+	})
+}
+
+func testAccBandWidthAssociate_ipv6Port(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_compute_flavors" "test" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "huaweicloud_images_image" "test" {
+  name        = "Ubuntu 18.04 server 64bit"
+  most_recent = true
+}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name        = "%[1]s"
+  vpc_id      = huaweicloud_vpc.test.id
+  cidr        = "192.168.0.0/24"
+  gateway_ip  = "192.168.0.1"
+  ipv6_enable = true
+}
+
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  name               = "%[1]s"
+  image_id           = data.huaweicloud_images_image.test.id
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids = [huaweicloud_networking_secgroup.test.id]
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+
+  network {
+    uuid        = huaweicloud_vpc_subnet.test.id
+    ipv6_enable = true
+  }
+}
+
+resource "huaweicloud_vpc_bandwidth" "test" {
+  name = "%[1]s"
+  size = 5
+}
+
+resource "huaweicloud_vpc_bandwidth_associate" "test" {
+  bandwidth_id = huaweicloud_vpc_bandwidth.test.id
+  port_id      = huaweicloud_compute_instance.test.network[0].port
+}
+`, name)
+}
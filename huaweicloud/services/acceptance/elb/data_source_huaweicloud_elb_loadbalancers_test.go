@@ -0,0 +1,53 @@
+package elb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceLoadBalancers_basic(t *testing.T) {
+	rName := "data.huaweicloud_elb_loadbalancers.test"
+	dc := acceptance.InitDataSourceCheck(rName)
+	name := acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceLoadBalancers_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "loadbalancers.#", "1"),
+					resource.TestCheckResourceAttr(rName, "loadbalancers.0.name", name),
+					resource.TestCheckResourceAttrPair(rName, "loadbalancers.0.id",
+						"huaweicloud_elb_loadbalancer.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "loadbalancers.0.ipv4_address",
+						"huaweicloud_elb_loadbalancer.test", "ipv4_address"),
+					resource.TestCheckResourceAttrPair(rName, "loadbalancers.0.ipv6_network_id",
+						"huaweicloud_elb_loadbalancer.test", "ipv6_network_id"),
+					resource.TestCheckResourceAttrPair(rName, "loadbalancers.0.ipv6_address",
+						"huaweicloud_elb_loadbalancer.test", "ipv6_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceLoadBalancers_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_elb_loadbalancers" "test" {
+  name = "%s"
+
+  depends_on = [
+    huaweicloud_elb_loadbalancer.test
+  ]
+}
+`, testAccElbV3LoadBalancerConfig_basic(name), name)
+}
@@ -0,0 +1,165 @@
+package elb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/elb"
+)
+
+func getPoolAttachmentResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("elb", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	protocolPort, err := strconv.Atoi(state.Primary.Attributes["protocol_port"])
+	if err != nil {
+		return nil, err
+	}
+	members, err := elb.QueryPoolMembers(client, state.Primary.Attributes["pool_id"], protocolPort)
+	if err != nil {
+		return nil, err
+	}
+	for key, address := range state.Primary.Attributes {
+		if !strings.HasPrefix(key, "backend_addresses.") || key == "backend_addresses.#" {
+			continue
+		}
+		if _, ok := members[address]; ok {
+			return members, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccPoolAttachment_ecsTags(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_elb_pool_attachment.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPoolAttachmentResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolAttachment_ecsTags(name, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "pool_id", "huaweicloud_elb_pool.test", "id"),
+					resource.TestCheckResourceAttr(rName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttr(rName, "deregistration_delay", "10"),
+					resource.TestCheckResourceAttr(rName, "backend_addresses.#", "1"),
+					resource.TestCheckResourceAttr(rName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "members.0.instance_id",
+						"huaweicloud_compute_instance.test.0", "id"),
+				),
+			},
+			{
+				Config: testAccPoolAttachment_ecsTags(name, 2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "backend_addresses.#", "2"),
+					resource.TestCheckResourceAttr(rName, "members.#", "2"),
+					resource.TestCheckResourceAttr(rName, "weight", "5"),
+					resource.TestCheckResourceAttr(rName, "members.0.weight", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoolAttachment_ecsTags(name string, count, weight int) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_compute_flavors" "test" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+data "huaweicloud_networking_secgroup" "test" {
+  name = "default"
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  count = %[2]d
+
+  name               = "%[1]s-${count.index}"
+  image_name         = "Ubuntu 18.04 server 64bit"
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids = [data.huaweicloud_networking_secgroup.test.id]
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+
+  network {
+    uuid = data.huaweicloud_vpc_subnet.test.id
+  }
+
+  tags = {
+    backend = "%[1]s"
+  }
+}
+
+resource "huaweicloud_elb_loadbalancer" "test" {
+  name           = "%[1]s"
+  vpc_id         = data.huaweicloud_vpc_subnet.test.vpc_id
+  ipv4_subnet_id = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0]
+  ]
+}
+
+resource "huaweicloud_elb_listener" "test" {
+  name            = "%[1]s"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+
+resource "huaweicloud_elb_pool" "test" {
+  name        = "%[1]s"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_elb_listener.test.id
+}
+
+resource "huaweicloud_elb_pool_attachment" "test" {
+  pool_id              = huaweicloud_elb_pool.test.id
+  protocol_port        = 8080
+  weight               = %[3]d
+  subnet_id            = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+  deregistration_delay = 10
+
+  ecs_tags = {
+    backend = "%[1]s"
+  }
+
+  depends_on = [huaweicloud_compute_instance.test]
+}
+`, name, count, weight)
+}
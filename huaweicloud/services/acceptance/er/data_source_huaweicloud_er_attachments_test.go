@@ -0,0 +1,69 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccAttachmentsDataSource_basic(t *testing.T) {
+	var (
+		dName    = "data.huaweicloud_er_attachments.test"
+		name     = acceptance.RandomAccResourceName()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+
+		dc = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttachmentsDataSource_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "attachments.0.id",
+						"huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttr(dName, "attachments.0.type", "vpc"),
+					resource.TestCheckResourceAttrPair(dName, "attachments.0.resource_id",
+						"huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(dName, "attachments.0.status"),
+					resource.TestCheckOutput("vpn_attachments_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAttachmentsDataSource_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_er_attachments" "test" {
+  depends_on = [huaweicloud_er_vpc_attachment.test]
+
+  instance_id = huaweicloud_er_instance.test.id
+  type        = "vpc"
+}
+
+data "huaweicloud_er_attachments" "vpn" {
+  depends_on = [huaweicloud_er_vpc_attachment.test]
+
+  instance_id = huaweicloud_er_instance.test.id
+  type        = "vpn"
+}
+
+output "vpn_attachments_count" {
+  value = length(data.huaweicloud_er_attachments.vpn.attachments)
+}
+`, testAccPropagation_base(name, bgpAsNum))
+}
@@ -0,0 +1,45 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccAttachmentAccepter_basic(t *testing.T) {
+	rName := "huaweicloud_er_attachment_accepter.test"
+
+	// The attachment must be created by another account through the shared ER instance, and waiting for acceptance.
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckERSharedAttachment(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttachmentAccepter_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "instance_id", acceptance.HW_ER_INSTANCE_ID),
+					resource.TestCheckResourceAttr(rName, "attachment_id", acceptance.HW_ER_SHARED_ATTACHMENT_ID),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttrSet(rName, "attachment_type"),
+					resource.TestCheckResourceAttrSet(rName, "resource_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAttachmentAccepter_basic() string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_attachment_accepter" "test" {
+  instance_id   = "%[1]s"
+  attachment_id = "%[2]s"
+  action        = "accept"
+}
+`, acceptance.HW_ER_INSTANCE_ID, acceptance.HW_ER_SHARED_ATTACHMENT_ID)
+}
@@ -0,0 +1,142 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/er"
+)
+
+func getFlowLogResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("er", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	return er.GetFlowLog(client, state.Primary.Attributes["instance_id"], state.Primary.ID)
+}
+
+func TestAccFlowLog_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName      = "huaweicloud_er_flow_log.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+		bgpAsNum   = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getFlowLogResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowLog_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "resource_id", "huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "log_group_id", "huaweicloud_lts_group.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "log_stream_id", "huaweicloud_lts_stream.test", "id"),
+					resource.TestCheckResourceAttr(rName, "resource_type", "attachment"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttr(rName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccFlowLog_update(updateName, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccFlowLogImportStateFunc(),
+			},
+		},
+	})
+}
+
+func testAccFlowLogImportStateFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		var instanceId, flowLogId string
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "huaweicloud_er_flow_log" {
+				instanceId = rs.Primary.Attributes["instance_id"]
+				flowLogId = rs.Primary.ID
+			}
+		}
+		if instanceId == "" || flowLogId == "" {
+			return "", fmt.Errorf("some import IDs are missing, want '<instance_id>/<id>', but '%s/%s'",
+				instanceId, flowLogId)
+		}
+		return fmt.Sprintf("%s/%s", instanceId, flowLogId), nil
+	}
+}
+
+func testAccFlowLog_base(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_lts_group" "test" {
+  group_name  = "%[2]s"
+  ttl_in_days = 1
+}
+
+resource "huaweicloud_lts_stream" "test" {
+  group_id    = huaweicloud_lts_group.test.id
+  stream_name = "%[2]s"
+}
+`, testAccPropagation_base(name, bgpAsNum), name)
+}
+
+func testAccFlowLog_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_flow_log" "test" {
+  instance_id   = huaweicloud_er_instance.test.id
+  resource_id   = huaweicloud_er_vpc_attachment.test.id
+  log_group_id  = huaweicloud_lts_group.test.id
+  log_stream_id = huaweicloud_lts_stream.test.id
+  name          = "%[2]s"
+  description   = "Created by acc test"
+}
+`, testAccFlowLog_base(name, bgpAsNum), name)
+}
+
+func testAccFlowLog_update(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_flow_log" "test" {
+  instance_id   = huaweicloud_er_instance.test.id
+  resource_id   = huaweicloud_er_vpc_attachment.test.id
+  log_group_id  = huaweicloud_lts_group.test.id
+  log_stream_id = huaweicloud_lts_stream.test.id
+  name          = "%[2]s"
+  enabled       = false
+}
+`, testAccFlowLog_base(name, bgpAsNum), name)
+}
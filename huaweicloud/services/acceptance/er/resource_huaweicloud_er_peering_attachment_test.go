@@ -0,0 +1,147 @@
+package er
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPeeringAttachmentResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("er", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/peering-attachments/{attachment_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{er_id}", state.Primary.Attributes["instance_id"])
+	getPath = strings.ReplaceAll(getPath, "{attachment_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccPeeringAttachment_basic(t *testing.T) {
+	var (
+		obj        interface{}
+		rName      = "huaweicloud_er_peering_attachment.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+		bgpAsNum   = acctest.RandIntRange(64512, 65000)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPeeringAttachmentResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckER(t)
+			acceptance.TestAccPreCheckERPeering(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testPeeringAttachment_basic(name, "Create by acc test", bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "peer_instance_id", "huaweicloud_er_instance.peer", "id"),
+					resource.TestCheckResourceAttr(rName, "peer_region", acceptance.HW_DEST_REGION),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Create by acc test"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+					resource.TestCheckResourceAttrSet(rName, "updated_at"),
+				),
+			},
+			{
+				Config: testPeeringAttachment_basic(updateName, "", bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPeeringAttachmentImportStateFunc(),
+			},
+		},
+	})
+}
+
+func testAccPeeringAttachmentImportStateFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		var instanceId, attachmentId string
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "huaweicloud_er_peering_attachment" {
+				instanceId = rs.Primary.Attributes["instance_id"]
+				attachmentId = rs.Primary.ID
+			}
+		}
+		if instanceId == "" || attachmentId == "" {
+			return "", fmt.Errorf("some import IDs are missing, want '<instance_id>/<attachment_id>', but '%s/%s'",
+				instanceId, attachmentId)
+		}
+		return fmt.Sprintf("%s/%s", instanceId, attachmentId), nil
+	}
+}
+
+func testPeeringAttachment_basic(name, description string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "peer" {
+  region = "%[1]s"
+}
+
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["%[2]s"]
+
+  name = "%[3]s"
+  asn  = %[5]d
+}
+
+resource "huaweicloud_er_instance" "peer" {
+  region             = "%[1]s"
+  availability_zones = [data.huaweicloud_availability_zones.peer.names[0]]
+
+  name = "%[3]s-peer"
+  asn  = %[5]d + 1
+}
+
+resource "huaweicloud_er_peering_attachment" "test" {
+  instance_id      = huaweicloud_er_instance.test.id
+  peer_instance_id = huaweicloud_er_instance.peer.id
+  peer_region      = "%[1]s"
+
+  name        = "%[3]s"
+  description = "%[4]s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, acceptance.HW_DEST_REGION, acceptance.HW_AVAILABILITY_ZONE, name, description, bgpAsNum)
+}
@@ -0,0 +1,117 @@
+package er
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/er"
+)
+
+func getStaticRouteResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("er", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	return er.GetStaticRoute(client, state.Primary.Attributes["route_table_id"], state.Primary.ID)
+}
+
+func TestAccStaticRoute_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName    = "huaweicloud_er_static_route.test"
+		name     = acceptance.RandomAccResourceName()
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getStaticRouteResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticRoute_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "route_table_id",
+						"huaweicloud_er_route_table.test", "id"),
+					resource.TestCheckResourceAttr(rName, "destination", "172.16.0.0/16"),
+					resource.TestCheckResourceAttrPair(rName, "attachment_id",
+						"huaweicloud_er_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttr(rName, "is_blackhole", "false"),
+					resource.TestCheckResourceAttrSet(rName, "type"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccStaticRoute_blackhole(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "attachment_id", ""),
+					resource.TestCheckResourceAttr(rName, "is_blackhole", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStaticRouteImportStateFunc(),
+			},
+		},
+	})
+}
+
+func testAccStaticRouteImportStateFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		var routeTableId, routeId string
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "huaweicloud_er_static_route" {
+				routeTableId = rs.Primary.Attributes["route_table_id"]
+				routeId = rs.Primary.ID
+			}
+		}
+		if routeTableId == "" || routeId == "" {
+			return "", fmt.Errorf("some import IDs are missing, want '<route_table_id>/<id>', but '%s/%s'",
+				routeTableId, routeId)
+		}
+		return fmt.Sprintf("%s/%s", routeTableId, routeId), nil
+	}
+}
+
+func testAccStaticRoute_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_static_route" "test" {
+  route_table_id = huaweicloud_er_route_table.test.id
+  destination    = "172.16.0.0/16"
+  attachment_id  = huaweicloud_er_vpc_attachment.test.id
+}
+`, testAccPropagation_base(name, bgpAsNum))
+}
+
+func testAccStaticRoute_blackhole(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_static_route" "test" {
+  route_table_id = huaweicloud_er_route_table.test.id
+  destination    = "172.16.0.0/16"
+  is_blackhole   = true
+}
+`, testAccPropagation_base(name, bgpAsNum))
+}
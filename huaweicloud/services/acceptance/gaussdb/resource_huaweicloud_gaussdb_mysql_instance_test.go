@@ -31,6 +31,7 @@ func TestAccGaussDBInstance_basic(t *testing.T) {
 					testAccCheckGaussDBInstanceExists(resourceName, &instance),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "audit_log_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
 				),
@@ -41,6 +42,8 @@ func TestAccGaussDBInstance_basic(t *testing.T) {
 					testAccCheckGaussDBInstanceExists(resourceName, &instance),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "audit_log_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_restart_parameters.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo_update", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
 				),
@@ -152,6 +155,11 @@ resource "huaweicloud_gaussdb_mysql_instance" "test" {
   security_group_id     = data.huaweicloud_networking_secgroup.test.id
   enterprise_project_id = "0"
 
+  parameters {
+    name  = "lock_wait_timeout"
+    value = "60"
+  }
+
   tags = {
     foo = "bar"
     key = "value"
@@ -178,6 +186,17 @@ resource "huaweicloud_gaussdb_mysql_instance" "test" {
   enterprise_project_id = "0"
   audit_log_enabled     = true
 
+  restart_on_parameter_change = true
+
+  parameters {
+    name  = "lock_wait_timeout"
+    value = "120"
+  }
+  parameters {
+    name  = "max_connections"
+    value = "1000"
+  }
+
   tags = {
     foo_update = "bar"
     key        = "value_update"
@@ -76,6 +76,10 @@ func TestAccOpenGaussInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "80"),
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.start_time", "08:00-09:00"),
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "8"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.name", "max_connections"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.value", "300"),
+					resource.TestCheckResourceAttr(resourceName, "pending_restart_parameters.#", "0"),
 				),
 			},
 		},
@@ -303,6 +307,13 @@ resource "huaweicloud_gaussdb_opengauss_instance" "test" {
     start_time = "08:00-09:00"
     keep_days  = 8
   }
+
+  restart_on_parameter_change = true
+
+  parameters {
+    name  = "max_connections"
+    value = "300"
+  }
 }
 `, testAccOpenGaussInstance_base(rName), rName, password)
 }
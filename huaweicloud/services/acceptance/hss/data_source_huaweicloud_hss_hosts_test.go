@@ -0,0 +1,71 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceHosts_basic(t *testing.T) {
+	var (
+		name = acceptance.RandomAccResourceName()
+
+		all         = "data.huaweicloud_hss_hosts.all"
+		byHostId    = "data.huaweicloud_hss_hosts.filter_by_host_id"
+		byProtected = "data.huaweicloud_hss_hosts.filter_by_version"
+		dcAll       = acceptance.InitDataSourceCheck(all)
+		dcByHostId  = acceptance.InitDataSourceCheck(byHostId)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceHosts_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dcAll.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(all, "hosts.#"),
+					dcByHostId.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byHostId, "hosts.#", "1"),
+					resource.TestCheckResourceAttrPair(byHostId, "hosts.0.id",
+						"huaweicloud_hss_host_protection.test", "id"),
+					resource.TestCheckResourceAttr(byHostId, "hosts.0.agent_status", "online"),
+					resource.TestCheckResourceAttr(byHostId, "hosts.0.version", "hss.version.enterprise"),
+					resource.TestCheckResourceAttrSet(byHostId, "hosts.0.vulnerability_risk_num"),
+					resource.TestCheckResourceAttrSet(byHostId, "hosts.0.baseline_risk_num"),
+					resource.TestCheckResourceAttr(byProtected, "hosts.0.version", "hss.version.enterprise"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceHosts_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_hss_host_protection" "test" {
+  host_id       = huaweicloud_compute_instance.test[0].id
+  version       = "hss.version.enterprise"
+  charging_mode = "postPaid"
+}
+
+data "huaweicloud_hss_hosts" "all" {
+  depends_on = [huaweicloud_hss_host_protection.test]
+}
+
+data "huaweicloud_hss_hosts" "filter_by_host_id" {
+  host_id = huaweicloud_hss_host_protection.test.id
+}
+
+data "huaweicloud_hss_hosts" "filter_by_version" {
+  version = huaweicloud_hss_host_protection.test.version
+}
+`, testAccHostGroup_base(name))
+}
@@ -0,0 +1,91 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/hss"
+)
+
+func getHostProtectionFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcHssV5Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	host, err := hss.QueryHostById(client, acceptance.HW_REGION_NAME, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST,
+		state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	if host.ProtectStatus != nil && *host.ProtectStatus == string(hss.ProtectStatusClosed) {
+		return nil, fmt.Errorf("the protection of the host (%s) is closed", state.Primary.ID)
+	}
+	return host, nil
+}
+
+func TestAccHostProtection_basic(t *testing.T) {
+	var (
+		host *hssv5model.Host
+
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_hss_host_protection.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&host,
+		getHostProtectionFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostProtection_basic(name, "hss.version.basic"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "host_id", "huaweicloud_compute_instance.test.0", "id"),
+					resource.TestCheckResourceAttr(rName, "version", "hss.version.basic"),
+					resource.TestCheckResourceAttr(rName, "charging_mode", "postPaid"),
+					resource.TestCheckResourceAttr(rName, "agent_status", "online"),
+					resource.TestCheckResourceAttrSet(rName, "policy_group_id"),
+				),
+			},
+			{
+				Config: testAccHostProtection_basic(name, "hss.version.enterprise"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "version", "hss.version.enterprise"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccHostProtection_basic(name, version string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_hss_host_protection" "test" {
+  host_id       = huaweicloud_compute_instance.test[0].id
+  version       = "%[2]s"
+  charging_mode = "postPaid"
+}
+`, testAccHostGroup_base(name), version)
+}
@@ -0,0 +1,87 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/hss"
+)
+
+func getPolicyGroupFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcHssV5Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	return hss.QueryPolicyGroupById(client, acceptance.HW_REGION_NAME, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST,
+		state.Primary.ID)
+}
+
+func TestAccPolicyGroup_basic(t *testing.T) {
+	var (
+		group *hssv5model.PolicyGroupResponseInfo
+
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_hss_policy_group.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&group,
+		getPolicyGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGroup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by terraform"),
+					resource.TestCheckResourceAttr(rName, "deletable", "true"),
+					resource.TestCheckResourceAttr(rName, "default_group", "false"),
+					resource.TestCheckResourceAttr(rName, "support_version", "hss.version.enterprise"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template_policy_group_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccPolicyGroup_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_hss_host_protection" "test" {
+  host_id       = huaweicloud_compute_instance.test[0].id
+  version       = "hss.version.enterprise"
+  charging_mode = "postPaid"
+}
+
+# The default policy group of the enterprise version is used as the template.
+resource "huaweicloud_hss_policy_group" "test" {
+  name                     = "%[2]s"
+  template_policy_group_id = huaweicloud_hss_host_protection.test.policy_group_id
+  description              = "Created by terraform"
+}
+`, testAccHostGroup_base(name), name)
+}
@@ -0,0 +1,79 @@
+package hss
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/hss"
+)
+
+func getQuotasFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return hss.QueryQuotasByOrderId(conf, acceptance.HW_REGION_NAME, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST,
+		state.Primary.ID)
+}
+
+func TestAccQuotas_basic(t *testing.T) {
+	var (
+		quotas interface{}
+
+		rName = "huaweicloud_hss_quotas.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&quotas,
+		getQuotasFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckChargingMode(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotas_basic("false"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "version", "hss.version.enterprise"),
+					resource.TestCheckResourceAttr(rName, "quantity", "2"),
+					resource.TestCheckResourceAttr(rName, "quotas.#", "2"),
+					resource.TestCheckResourceAttr(rName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccQuotas_basic("true"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "auto_renew", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"period_unit", "period", "auto_renew", "tags",
+				},
+			},
+		},
+	})
+}
+
+func testAccQuotas_basic(autoRenew string) string {
+	return `
+resource "huaweicloud_hss_quotas" "test" {
+  version     = "hss.version.enterprise"
+  period_unit = "month"
+  period      = 1
+  auto_renew  = "` + autoRenew + `"
+  quantity    = 2
+}
+`
+}
@@ -0,0 +1,82 @@
+package iam
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccIdentityPolicyDocumentDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_identity_policy_document.test"
+	expectedJson := `{"Statement":[{"Action":["obs:bucket:DeleteBucket"],"Effect":"Deny"},` +
+		`{"Action":["obs:bucket:ListAllMyBuckets","obs:object:GetObject"],"Condition":{"StringEquals":` +
+		`{"g:UserName":["bar","foo"]}},"Effect":"Allow","Resource":["obs:*:*:object:*"]}],"Version":"1.1"}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityPolicyDocumentDataSource_invalidAction,
+				ExpectError: regexp.MustCompile("unknown service or resource type"),
+			},
+			{
+				Config: testAccIdentityPolicyDocumentDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", expectedJson),
+					resource.TestCheckResourceAttrSet("huaweicloud_identity_role.test", "id"),
+				),
+			},
+			{
+				Config:   testAccIdentityPolicyDocumentDataSource_basic(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityPolicyDocumentDataSource_invalidAction = `
+data "huaweicloud_identity_policy_document" "test" {
+  statement {
+    actions = ["obs:buckets:ListAllMyBuckets"]
+  }
+}
+`
+
+func testAccIdentityPolicyDocumentDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_identity_policy_document" "test" {
+  statement {
+    effect    = "Allow"
+    actions   = ["obs:object:GetObject", "obs:bucket:ListAllMyBuckets"]
+    resources = ["obs:*:*:object:*"]
+
+    condition {
+      operator = "StringEquals"
+      key      = "g:UserName"
+      values   = ["foo", "bar"]
+    }
+  }
+
+  statement {
+    effect  = "Deny"
+    actions = ["obs:bucket:DeleteBucket"]
+  }
+}
+
+resource "huaweicloud_identity_role" "test" {
+  name        = "%s"
+  description = "created by terraform"
+  type        = "AX"
+  policy      = data.huaweicloud_identity_policy_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,66 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+// The login policy is a singleton of the account, so the test can not run in parallel.
+func TestAccIdentityLoginPolicy_basic(t *testing.T) {
+	resourceName := "huaweicloud_identity_login_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityLoginPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login_failed_times", "3"),
+					resource.TestCheckResourceAttr(resourceName, "lockout_duration", "30"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout", "30"),
+					resource.TestCheckResourceAttr(resourceName, "show_recent_login_info", "true"),
+					resource.TestCheckResourceAttr(resourceName, "custom_info_for_login", "authorized users only"),
+				),
+			},
+			{
+				Config: testAccIdentityLoginPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login_failed_times", "5"),
+					resource.TestCheckResourceAttr(resourceName, "account_validity_period", "90"),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "show_recent_login_info", "false"),
+					resource.TestCheckResourceAttr(resourceName, "custom_info_for_login", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityLoginPolicy_basic = `
+resource "huaweicloud_identity_login_policy" "test" {
+  login_failed_times     = 3
+  lockout_duration       = 30
+  session_timeout        = 30
+  show_recent_login_info = true
+  custom_info_for_login  = "authorized users only"
+}
+`
+
+const testAccIdentityLoginPolicy_update = `
+resource "huaweicloud_identity_login_policy" "test" {
+  account_validity_period = 90
+  session_timeout         = 120
+}
+`
@@ -0,0 +1,70 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+// The password policy is a singleton of the account, so the test can not run in parallel.
+func TestAccIdentityPasswordPolicy_basic(t *testing.T) {
+	resourceName := "huaweicloud_identity_password_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPasswordPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_char_combination", "3"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_password_length", "12"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_recent_passwords_disallowed", "5"),
+					resource.TestCheckResourceAttr(resourceName, "password_validity_period", "90"),
+					resource.TestCheckResourceAttr(resourceName, "password_not_username_or_invert", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "maximum_password_length"),
+					resource.TestCheckResourceAttrSet(resourceName, "password_requirements"),
+				),
+			},
+			{
+				Config: testAccIdentityPasswordPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_char_combination", "4"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_password_length", "16"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_consecutive_identical_chars", "2"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_password_age", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityPasswordPolicy_basic = `
+resource "huaweicloud_identity_password_policy" "test" {
+  password_char_combination             = 3
+  minimum_password_length               = 12
+  number_of_recent_passwords_disallowed = 5
+  password_validity_period              = 90
+}
+`
+
+const testAccIdentityPasswordPolicy_update = `
+resource "huaweicloud_identity_password_policy" "test" {
+  password_char_combination             = 4
+  minimum_password_length               = 16
+  maximum_consecutive_identical_chars   = 2
+  number_of_recent_passwords_disallowed = 5
+  password_validity_period              = 90
+  minimum_password_age                  = 60
+}
+`
@@ -0,0 +1,66 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+// The protection policy is a singleton of the account, so the test can not run in parallel.
+func TestAccIdentityProtectionPolicy_basic(t *testing.T) {
+	resourceName := "huaweicloud_identity_protection_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProtectionPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protection_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_management.0.access_key", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_management.0.password", "true"),
+				),
+			},
+			{
+				Config: testAccIdentityProtectionPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "self_management.0.access_key", "true"),
+					resource.TestCheckResourceAttr(resourceName, "self_management.0.mobile", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIdentityProtectionPolicy_basic = `
+resource "huaweicloud_identity_protection_policy" "test" {
+  protection_enabled = true
+
+  self_management {
+    access_key = false
+  }
+}
+`
+
+const testAccIdentityProtectionPolicy_update = `
+resource "huaweicloud_identity_protection_policy" "test" {
+  protection_enabled = false
+
+  self_management {
+    mobile = false
+  }
+}
+`
@@ -170,3 +170,108 @@ resource "huaweicloud_identity_role_assignment" "role_assignment_1" {
 }
 `, rName, acceptance.HW_DOMAIN_ID)
 }
+
+func TestAccIdentityV3RoleAssignment_enterpriseProject(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	groupAssignment := "huaweicloud_identity_role_assignment.group"
+	userAssignment := "huaweicloud_identity_role_assignment.user"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3RoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3RoleAssignment_enterpriseProject(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(groupAssignment, "group_id",
+						"huaweicloud_identity_group.test", "id"),
+					resource.TestCheckResourceAttr(groupAssignment, "enterprise_project_id",
+						acceptance.HW_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttrPair(userAssignment, "user_id",
+						"huaweicloud_identity_user.test", "id"),
+					resource.TestCheckResourceAttr(userAssignment, "enterprise_project_id",
+						acceptance.HW_ENTERPRISE_PROJECT_ID_TEST),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityV3RoleAssignment_allProjects(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_identity_role_assignment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIdentityV3RoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3RoleAssignment_allProjects(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id",
+						"huaweicloud_identity_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "domain_id", acceptance.HW_DOMAIN_ID),
+					resource.TestCheckResourceAttr(resourceName, "all_projects", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityV3RoleAssignment_enterpriseProject(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_identity_role" "test" {
+  name = "rds_adm"
+}
+
+resource "huaweicloud_identity_group" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_identity_user" "test" {
+  name     = "%[1]s"
+  password = "password12345!"
+  enabled  = true
+}
+
+resource "huaweicloud_identity_role_assignment" "group" {
+  role_id               = data.huaweicloud_identity_role.test.id
+  group_id              = huaweicloud_identity_group.test.id
+  enterprise_project_id = "%[2]s"
+}
+
+resource "huaweicloud_identity_role_assignment" "user" {
+  role_id               = data.huaweicloud_identity_role.test.id
+  user_id               = huaweicloud_identity_user.test.id
+  enterprise_project_id = "%[2]s"
+}
+`, rName, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST)
+}
+
+func testAccIdentityV3RoleAssignment_allProjects(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_identity_role" "test" {
+  name = "rds_adm"
+}
+
+resource "huaweicloud_identity_group" "test" {
+  name = "%s"
+}
+
+resource "huaweicloud_identity_role_assignment" "test" {
+  role_id      = data.huaweicloud_identity_role.test.id
+  group_id     = huaweicloud_identity_group.test.id
+  domain_id    = "%s"
+  all_projects = true
+}
+`, rName, acceptance.HW_DOMAIN_ID)
+}
@@ -51,6 +51,7 @@ func TestAccIdentityV3User_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "pwd_reset", "true"),
 					resource.TestCheckResourceAttr(resourceName, "email", "user_1@abc.com"),
 					resource.TestCheckResourceAttr(resourceName, "password_strength", "Strong"),
+					resource.TestCheckResourceAttr(resourceName, "verification_method", "email"),
 				),
 			},
 			{
@@ -70,6 +71,7 @@ func TestAccIdentityV3User_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "pwd_reset", "false"),
 					resource.TestCheckResourceAttr(resourceName, "email", "user_1@abcd.com"),
+					resource.TestCheckResourceAttr(resourceName, "verification_method", ""),
 				),
 			},
 		},
@@ -79,11 +81,12 @@ func TestAccIdentityV3User_basic(t *testing.T) {
 func testAccIdentityV3User_basic(userName string) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_identity_user" "user_1" {
-  name        = "%s"
-  password    = "password123@!"
-  enabled     = true
-  email       = "user_1@abc.com"
-  description = "tested by terraform"
+  name                = "%s"
+  password            = "password123@!"
+  enabled             = true
+  email               = "user_1@abc.com"
+  description         = "tested by terraform"
+  verification_method = "email"
 }
 `, userName)
 }
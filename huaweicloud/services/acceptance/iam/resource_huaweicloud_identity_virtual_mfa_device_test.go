@@ -0,0 +1,89 @@
+package iam
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getIdentityVirtualMfaDeviceResourceFunc(c *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := c.HcIamV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating IAM client: %s", err)
+	}
+
+	response, err := client.ListUserMfaDevices(&iam.ListUserMfaDevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if response.VirtualMfaDevices != nil {
+		for _, device := range *response.VirtualMfaDevices {
+			if device.SerialNumber == state.Primary.ID {
+				return device, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("the virtual MFA device (%s) does not exist", state.Primary.ID)
+}
+
+func TestAccIdentityVirtualMfaDevice_basic(t *testing.T) {
+	var device iam.MfaDeviceResult
+	name := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_identity_virtual_mfa_device.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&device,
+		getIdentityVirtualMfaDeviceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityVirtualMfaDevice_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "huaweicloud_identity_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "base32_string_seed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"name", "base32_string_seed",
+				},
+			},
+		},
+	})
+}
+
+func testAccIdentityVirtualMfaDevice_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_identity_user" "test" {
+  name     = "%[1]s"
+  password = "password123@!"
+  enabled  = true
+}
+
+resource "huaweicloud_identity_virtual_mfa_device" "test" {
+  name    = "%[1]s"
+  user_id = huaweicloud_identity_user.test.id
+}
+`, name)
+}
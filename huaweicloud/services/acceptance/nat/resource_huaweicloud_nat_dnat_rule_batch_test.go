@@ -0,0 +1,225 @@
+package nat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDnatRuleBatchResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("nat", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v2/{project_id}/dnat_rules?nat_gateway_id={nat_gateway_id}"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{nat_gateway_id}", state.Primary.Attributes["nat_gateway_id"])
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	// Only the rules managed by the resource are checked.
+	ruleIds := make(map[string]bool)
+	for k, v := range state.Primary.Attributes {
+		if strings.HasPrefix(k, "rules.") && strings.HasSuffix(k, ".id") {
+			ruleIds[v] = true
+		}
+	}
+	rules := make([]interface{}, 0)
+	for _, rule := range utils.PathSearch("dnat_rules", respBody, make([]interface{}, 0)).([]interface{}) {
+		if ruleIds[utils.PathSearch("id", rule, "").(string)] {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) < 1 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rules, nil
+}
+
+// testAccDnatRuleBatchImportStateIdFunc builds the import ID with the NAT gateway ID and the IDs of the managed rules.
+func testAccDnatRuleBatchImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", rName, rs)
+		}
+		ruleIds := make([]string, 0)
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "rules.") && strings.HasSuffix(k, ".id") {
+				ruleIds = append(ruleIds, v)
+			}
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["nat_gateway_id"], strings.Join(ruleIds, ",")), nil
+	}
+}
+
+func TestAccDnatRuleBatch_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		rName = "huaweicloud_nat_dnat_rule_batch.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDnatRuleBatchResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnatRuleBatch_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "nat_gateway_id", "huaweicloud_nat_gateway.test", "id"),
+					resource.TestCheckResourceAttr(rName, "rules.#", "3"),
+				),
+			},
+			{
+				Config: testAccDnatRuleBatch_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "rules.*", map[string]string{
+						"protocol":                    "udp",
+						"internal_service_port_range": "3000-3010",
+						"external_service_port_range": "4000-4010",
+						"status":                      "ACTIVE",
+					}),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDnatRuleBatchImportStateIdFunc(rName),
+				// The resource ID is generated during the import, so the attributes are checked instead.
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, but got %d", len(states))
+					}
+					if states[0].Attributes["rules.#"] != "2" {
+						return fmt.Errorf("expected 2 imported DNAT rules, but got %s", states[0].Attributes["rules.#"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccDnatRuleBatch_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  vpc_id     = huaweicloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "huaweicloud_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+
+  bandwidth {
+    name        = "%[1]s"
+    size        = 5
+    share_type  = "PER"
+    charge_mode = "traffic"
+  }
+}
+
+resource "huaweicloud_nat_gateway" "test" {
+  name      = "%[1]s"
+  spec      = "1"
+  vpc_id    = huaweicloud_vpc.test.id
+  subnet_id = huaweicloud_vpc_subnet.test.id
+}
+`, name)
+}
+
+func testAccDnatRuleBatch_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_dnat_rule_batch" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    private_ip            = "192.168.0.10"
+    protocol              = "tcp"
+    internal_service_port = 80
+    external_service_port = 8080
+  }
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    private_ip            = "192.168.0.11"
+    protocol              = "tcp"
+    internal_service_port = 80
+    external_service_port = 8081
+  }
+  rules {
+    floating_ip_id              = huaweicloud_vpc_eip.test.id
+    private_ip                  = "192.168.0.12"
+    protocol                    = "tcp"
+    internal_service_port_range = "1000-1010"
+    external_service_port_range = "2000-2010"
+    description                 = "Created by acc test"
+  }
+}
+`, testAccDnatRuleBatch_base(name))
+}
+
+func testAccDnatRuleBatch_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_dnat_rule_batch" "test" {
+  nat_gateway_id = huaweicloud_nat_gateway.test.id
+
+  rules {
+    floating_ip_id        = huaweicloud_vpc_eip.test.id
+    private_ip            = "192.168.0.10"
+    protocol              = "tcp"
+    internal_service_port = 80
+    external_service_port = 8080
+  }
+  rules {
+    floating_ip_id              = huaweicloud_vpc_eip.test.id
+    private_ip                  = "192.168.0.13"
+    protocol                    = "udp"
+    internal_service_port_range = "3000-3010"
+    external_service_port_range = "4000-4010"
+  }
+}
+`, testAccDnatRuleBatch_base(name))
+}
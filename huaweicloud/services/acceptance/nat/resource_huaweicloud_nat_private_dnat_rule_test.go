@@ -0,0 +1,118 @@
+package nat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPrivateDnatRuleResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("nat", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{rule_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccPrivateDnatRule_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		rName = "huaweicloud_nat_private_dnat_rule.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateDnatRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateDnatRule_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "gateway_id", "huaweicloud_nat_private_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "huaweicloud_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttr(rName, "backend_private_ip", "192.168.16.10"),
+					resource.TestCheckResourceAttr(rName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(rName, "internal_service_port", "80"),
+					resource.TestCheckResourceAttr(rName, "transit_service_port", "8080"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+				),
+			},
+			{
+				Config: testAccPrivateDnatRule_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "huaweicloud_nat_private_transit_ip.test.1", "id"),
+					resource.TestCheckResourceAttr(rName, "protocol", "udp"),
+					resource.TestCheckResourceAttr(rName, "internal_service_port", "1000-1010"),
+					resource.TestCheckResourceAttr(rName, "transit_service_port", "2000-2010"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPrivateDnatRule_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_private_dnat_rule" "test" {
+  gateway_id            = huaweicloud_nat_private_gateway.test.id
+  transit_ip_id         = huaweicloud_nat_private_transit_ip.test[0].id
+  backend_private_ip    = "192.168.16.10"
+  protocol              = "tcp"
+  internal_service_port = "80"
+  transit_service_port  = "8080"
+  description           = "Created by acc test"
+}
+`, testAccPrivateSnatRule_base(name))
+}
+
+func testAccPrivateDnatRule_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_private_dnat_rule" "test" {
+  gateway_id            = huaweicloud_nat_private_gateway.test.id
+  transit_ip_id         = huaweicloud_nat_private_transit_ip.test[1].id
+  backend_private_ip    = "192.168.16.10"
+  protocol              = "udp"
+  internal_service_port = "1000-1010"
+  transit_service_port  = "2000-2010"
+}
+`, testAccPrivateSnatRule_base(name))
+}
@@ -0,0 +1,125 @@
+package nat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPrivateSnatRuleResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("nat", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{rule_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccPrivateSnatRule_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		rName = "huaweicloud_nat_private_snat_rule.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateSnatRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateSnatRule_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "gateway_id", "huaweicloud_nat_private_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "huaweicloud_nat_private_transit_ip.test.0", "id"),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+				),
+			},
+			{
+				Config: testAccPrivateSnatRule_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "transit_ip_id", "huaweicloud_nat_private_transit_ip.test.1", "id"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPrivateSnatRule_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "huaweicloud_nat_private_gateway" "test" {
+  subnet_id = huaweicloud_vpc_subnet.test.id
+  name      = "%[3]s"
+}
+
+resource "huaweicloud_nat_private_transit_ip" "test" {
+  count = 2
+
+  subnet_id = huaweicloud_vpc_subnet.transit.id
+}
+`, testAccPrivateGateway_base(name), testAccPrivateTransitIp_base(name), name)
+}
+
+func testAccPrivateSnatRule_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_private_snat_rule" "test" {
+  gateway_id    = huaweicloud_nat_private_gateway.test.id
+  transit_ip_id = huaweicloud_nat_private_transit_ip.test[0].id
+  subnet_id     = huaweicloud_vpc_subnet.test.id
+  description   = "Created by acc test"
+}
+`, testAccPrivateSnatRule_base(name))
+}
+
+func testAccPrivateSnatRule_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_private_snat_rule" "test" {
+  gateway_id    = huaweicloud_nat_private_gateway.test.id
+  transit_ip_id = huaweicloud_nat_private_transit_ip.test[1].id
+  subnet_id     = huaweicloud_vpc_subnet.test.id
+}
+`, testAccPrivateSnatRule_base(name))
+}
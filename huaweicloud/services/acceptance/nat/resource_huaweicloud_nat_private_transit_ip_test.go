@@ -0,0 +1,106 @@
+package nat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPrivateTransitIpResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("nat", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/transit-ips/{transit_ip_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{transit_ip_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccPrivateTransitIp_basic(t *testing.T) {
+	var (
+		obj   interface{}
+		rName = "huaweicloud_nat_private_transit_ip.test"
+		name  = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPrivateTransitIpResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateTransitIp_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "huaweicloud_vpc_subnet.transit", "id"),
+					resource.TestCheckResourceAttr(rName, "ip_address", "172.20.1.10"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(rName, "network_interface_id"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccPrivateTransitIp_base creates a transit VPC and subnet, which are used to connect the private NAT gateway
+// with the remote networks.
+func testAccPrivateTransitIp_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "transit" {
+  name = "%[1]s-transit"
+  cidr = "172.20.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "transit" {
+  vpc_id     = huaweicloud_vpc.transit.id
+  name       = "%[1]s-transit"
+  cidr       = "172.20.1.0/24"
+  gateway_ip = "172.20.1.1"
+}
+`, name)
+}
+
+func testAccPrivateTransitIp_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_nat_private_transit_ip" "test" {
+  subnet_id  = huaweicloud_vpc_subnet.transit.id
+  ip_address = "172.20.1.10"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccPrivateTransitIp_base(name))
+}
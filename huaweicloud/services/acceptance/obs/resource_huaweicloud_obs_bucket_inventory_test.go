@@ -0,0 +1,116 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getObsBucketInventoryResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := conf.ObjectStorageClientWithV4Signature(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	signed, err := obsClient.CreateSignedUrl(&obs.CreateSignedUrlInput{
+		Method: obs.HttpMethodGet,
+		Bucket: state.Primary.Attributes["bucket"],
+		QueryParams: map[string]string{
+			"inventory": "",
+			"id":        state.Primary.Attributes["name"],
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obsClient.GetObjectWithSignedUrl(signed.SignedUrl, signed.ActualSignedRequestHeaders)
+}
+
+func TestAccObsBucketInventory_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_obs_bucket_inventory.test"
+		name  = fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketInventoryResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketInventory_basic(name, "Daily", true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", "daily-report"),
+					resource.TestCheckResourceAttr(rName, "frequency", "Daily"),
+					resource.TestCheckResourceAttr(rName, "enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "included_object_versions", "Current"),
+					resource.TestCheckResourceAttr(rName, "filter_prefix", "data/"),
+					resource.TestCheckResourceAttrPair(rName, "destination.0.bucket",
+						"huaweicloud_obs_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(rName, "destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(rName, "optional_fields.#", "2"),
+				),
+			},
+			{
+				Config: testAccObsBucketInventory_basic(name, "Weekly", false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "frequency", "Weekly"),
+					resource.TestCheckResourceAttr(rName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketInventory_basic(name, frequency string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "huaweicloud_obs_bucket" "destination" {
+  bucket = "%[1]s-destination"
+}
+
+resource "huaweicloud_obs_bucket_inventory" "test" {
+  bucket        = huaweicloud_obs_bucket.source.bucket
+  name          = "daily-report"
+  frequency     = "%[2]s"
+  enabled       = %[3]t
+  filter_prefix = "data/"
+
+  optional_fields = ["Size", "LastModifiedDate"]
+
+  destination {
+    bucket = huaweicloud_obs_bucket.destination.bucket
+    prefix = "inventory/"
+  }
+}
+`, name, frequency, enabled)
+}
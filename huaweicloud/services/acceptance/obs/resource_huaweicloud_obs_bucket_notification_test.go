@@ -0,0 +1,145 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getObsBucketNotificationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := conf.ObjectStorageClientWithSignature(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+	output, err := obsClient.GetBucketNotification(state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The function notifications are not parsed by the SDK, so only the topic notifications are checked.
+	if len(output.TopicConfigurations) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return output, nil
+}
+
+func TestAccObsBucketNotification_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_obs_bucket_notification.test"
+		name  = fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketNotificationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketNotification_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "topic.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "topic.0.urn", "huaweicloud_smn_topic.test", "topic_urn"),
+					resource.TestCheckResourceAttr(rName, "topic.0.events.#", "1"),
+					resource.TestCheckResourceAttr(rName, "topic.0.prefix", "input/"),
+					resource.TestCheckResourceAttrSet(rName, "topic.0.id"),
+				),
+			},
+			{
+				Config: testAccObsBucketNotification_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "topic.0.events.#", "2"),
+					resource.TestCheckResourceAttr(rName, "topic.0.suffix", ".log"),
+					resource.TestCheckResourceAttr(rName, "function.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "function.0.urn", "huaweicloud_fgs_function.test", "urn"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketNotification_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket = "%[1]s"
+}
+
+resource "huaweicloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+`, name)
+}
+
+func testAccObsBucketNotification_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_obs_bucket_notification" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+
+  topic {
+    urn    = huaweicloud_smn_topic.test.topic_urn
+    events = ["ObjectCreated:*"]
+    prefix = "input/"
+  }
+}
+`, testAccObsBucketNotification_base(name))
+}
+
+func testAccObsBucketNotification_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_fgs_function" "test" {
+  name        = replace("%[2]s", "-", "_")
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 3
+  runtime     = "Python2.7"
+  code_type   = "inline"
+  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGV2ZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+}
+
+resource "huaweicloud_obs_bucket_notification" "test" {
+  bucket = huaweicloud_obs_bucket.test.bucket
+
+  topic {
+    urn    = huaweicloud_smn_topic.test.topic_urn
+    events = ["ObjectCreated:*", "ObjectRemoved:*"]
+    prefix = "input/"
+    suffix = ".log"
+  }
+
+  function {
+    urn    = huaweicloud_fgs_function.test.urn
+    events = ["ObjectCreated:Put"]
+    prefix = "ingest/"
+  }
+}
+`, testAccObsBucketNotification_base(name), name)
+}
@@ -0,0 +1,146 @@
+package obs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getObsBucketObjectsSyncResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := conf.ObjectStorageClient(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	input := &obs.ListObjectsInput{
+		Bucket: state.Primary.Attributes["bucket"],
+	}
+	input.Prefix = state.Primary.Attributes["key_prefix"]
+	output, err := obsClient.ListObjects(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Contents) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return output.Contents, nil
+}
+
+func writeObjectsSyncTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAccObsBucketObjectsSync_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName     = "huaweicloud_obs_bucket_objects_sync.test"
+		name      = fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+		sourceDir = t.TempDir()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketObjectsSyncResourceFunc,
+	)
+
+	writeObjectsSyncTestFiles(t, sourceDir, map[string]string{
+		"index.html":     "<html>index</html>",
+		"css/style.css":  "body {}",
+		"js/app.js":      "console.log('v1')",
+		"obsolete.txt":   "obsolete",
+		"images/a.svg":   "<svg></svg>",
+		"images/b.svg":   "<svg></svg>",
+		"docs/readme.md": "# readme",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectsSync_basic(name, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "files.%", "7"),
+					resource.TestCheckResourceAttr(rName, "files.site/index.html",
+						"633b265aed14daaf83626aa33f0d5d7f"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeObjectsSyncTestFiles(t, sourceDir, map[string]string{
+						"js/app.js": "console.log('v2')",
+					})
+					if err := os.Remove(filepath.Join(sourceDir, "obsolete.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObsBucketObjectsSync_basic(name, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "files.%", "6"),
+					resource.TestCheckNoResourceAttr(rName, "files.site/obsolete.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObsBucketObjectsSync_basic(name, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_obs_bucket_objects_sync" "test" {
+  bucket        = huaweicloud_obs_bucket.test.bucket
+  source_dir    = "%[2]s"
+  key_prefix    = "site/"
+  delete_remote = true
+  part_size     = 5
+  parallel      = 4
+
+  header_rule {
+    glob          = "*.html"
+    content_type  = "text/html; charset=utf-8"
+    cache_control = "no-cache"
+  }
+
+  header_rule {
+    glob          = "images/*"
+    cache_control = "max-age=86400"
+
+    metadata = {
+      owner = "terraform"
+    }
+  }
+}
+`, name, filepath.ToSlash(sourceDir))
+}
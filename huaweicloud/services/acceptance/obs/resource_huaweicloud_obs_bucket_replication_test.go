@@ -0,0 +1,103 @@
+package obs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getObsBucketReplicationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	obsClient, err := conf.ObjectStorageClient(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+	return obsClient.GetBucketReplication(state.Primary.ID)
+}
+
+func TestAccObsBucketReplication_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName = "huaweicloud_obs_bucket_replication.test"
+		name  = fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getObsBucketReplicationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+			acceptance.TestAccPreCheckOBSReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketReplication_basic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "destination_bucket",
+						"huaweicloud_obs_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(rName, "agency", acceptance.HW_OBS_REPLICATION_AGENCY),
+					resource.TestCheckResourceAttr(rName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(rName, "rule.0.prefix", "log"),
+					resource.TestCheckResourceAttr(rName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "rule.0.storage_class", "WARM"),
+					resource.TestCheckResourceAttrSet(rName, "rule.0.id"),
+				),
+			},
+			{
+				Config: testAccObsBucketReplication_basic(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(rName, "rule.0.delete_data", "true"),
+					resource.TestCheckResourceAttr(rName, "rule.0.history_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObsBucketReplication_basic(name string, updated bool) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "huaweicloud_obs_bucket" "destination" {
+  region = "%[2]s"
+  bucket = "%[1]s-destination"
+}
+
+resource "huaweicloud_obs_bucket_replication" "test" {
+  bucket             = huaweicloud_obs_bucket.source.bucket
+  destination_bucket = huaweicloud_obs_bucket.destination.bucket
+  agency             = "%[3]s"
+
+  rule {
+    prefix          = "log"
+    storage_class   = "WARM"
+    enabled         = %[4]t
+    delete_data     = %[5]t
+    history_enabled = %[5]t
+  }
+}
+`, name, acceptance.HW_DEST_REGION, acceptance.HW_OBS_REPLICATION_AGENCY, !updated, updated)
+}
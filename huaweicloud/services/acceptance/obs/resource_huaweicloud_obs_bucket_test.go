@@ -234,6 +234,45 @@ func TestAccObsBucket_versioning(t *testing.T) {
 	})
 }
 
+func TestAccObsBucket_worm(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "huaweicloud_obs_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfigWithWorm(rInt, "days = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "versioning", "true"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.days", "1"),
+				),
+			},
+			{
+				Config: testAccObsBucketConfigWithWorm(rInt, "years = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.years", "1"),
+					resource.TestCheckResourceAttr(resourceName, "worm_policy.0.days", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "force_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccObsBucket_logging(t *testing.T) {
 	rInt := acctest.RandInt()
 	targetBucket := fmt.Sprintf("tf-test-log-bucket-%d", rInt)
@@ -581,6 +620,21 @@ resource "huaweicloud_obs_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccObsBucketConfigWithWorm(randInt int, retention string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "bucket" {
+  bucket              = "tf-test-bucket-%d"
+  acl                 = "private"
+  versioning          = true
+  object_lock_enabled = true
+
+  worm_policy {
+    %s
+  }
+}
+`, randInt, retention)
+}
+
 func testAccObsBucketConfigWithLogging(randInt int) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_obs_bucket" "log_bucket" {
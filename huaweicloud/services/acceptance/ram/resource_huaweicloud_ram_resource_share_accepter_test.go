@@ -0,0 +1,43 @@
+package ram
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccResourceShareAccepter_basic(t *testing.T) {
+	rName := "huaweicloud_ram_resource_share_accepter.test"
+
+	// The resource share must be shared with the current account and its invitation is in pending status.
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRAMShareInvitation(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareAccepter_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "resource_share_id",
+						acceptance.HW_RAM_SHARE_INVITATION_SHARE_ID),
+					resource.TestCheckResourceAttr(rName, "status", "accepted"),
+					resource.TestCheckResourceAttrSet(rName, "resource_share_name"),
+					resource.TestCheckResourceAttrSet(rName, "sender_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceShareAccepter_basic() string {
+	return fmt.Sprintf(`
+resource "huaweicloud_ram_resource_share_accepter" "test" {
+  resource_share_id = "%s"
+}
+`, acceptance.HW_RAM_SHARE_INVITATION_SHARE_ID)
+}
@@ -0,0 +1,119 @@
+package ram
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ram"
+)
+
+func getResourceShareResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("ram", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RAM client: %s", err)
+	}
+
+	return ram.GetResourceShare(client, state.Primary.ID)
+}
+
+func TestAccResourceShare_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		rName      = "huaweicloud_ram_resource_share.test"
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+		bgpAsNum   = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getResourceShareResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRAMShareAccount(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShare_basic(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttr(rName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(rName, "principals.0", acceptance.HW_RAM_SHARE_ACCOUNT_ID),
+					resource.TestCheckResourceAttr(rName, "resource_urns.#", "1"),
+					resource.TestCheckResourceAttrSet(rName, "owning_account_id"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccResourceShare_update(updateName, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "resource_urns.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceShare_base(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_er_instance" "test" {
+  count = 2
+
+  availability_zones = ["%[1]s"]
+
+  name = "%[2]s-${count.index}"
+  asn  = %[3]d
+}
+`, acceptance.HW_AVAILABILITY_ZONE, name, bgpAsNum)
+}
+
+func testAccResourceShare_basic(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_ram_resource_share" "test" {
+  name          = "%[2]s"
+  description   = "Created by acc test"
+  principals    = ["%[3]s"]
+  resource_urns = ["er:%[4]s:%[5]s:instances:${huaweicloud_er_instance.test[0].id}"]
+}
+`, testAccResourceShare_base(name, bgpAsNum), name, acceptance.HW_RAM_SHARE_ACCOUNT_ID,
+		acceptance.HW_REGION_NAME, acceptance.HW_DOMAIN_ID)
+}
+
+func testAccResourceShare_update(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_ram_resource_share" "test" {
+  name          = "%[2]s"
+  principals    = ["%[3]s"]
+  resource_urns = [for v in huaweicloud_er_instance.test[*].id : "er:%[4]s:%[5]s:instances:${v}"]
+}
+`, testAccResourceShare_base(name, bgpAsNum), name, acceptance.HW_RAM_SHARE_ACCOUNT_ID,
+		acceptance.HW_REGION_NAME, acceptance.HW_DOMAIN_ID)
+}
@@ -0,0 +1,88 @@
+package rds
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsInstanceRestore_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_instance_restore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRdsInstanceRestore_conflict(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one of `source.0.backup_id,source.0.restore_time` can be specified"),
+			},
+			{
+				Config: testAccRdsInstanceRestore_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_rds_instance.target", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.0.backup_id",
+						"huaweicloud_rds_backup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsInstanceRestore_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_instance" "target" {
+  name              = "%s-target"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+  time_zone         = "UTC+08:00"
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+    port     = 8635
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+
+resource "huaweicloud_rds_instance_restore" "test" {
+  instance_id = huaweicloud_rds_instance.target.id
+
+  source {
+    instance_id = huaweicloud_rds_instance.test.id
+    backup_id   = huaweicloud_rds_backup.test.id
+  }
+}
+`, testBackup_basic(name), name)
+}
+
+func testAccRdsInstanceRestore_conflict() string {
+	return `
+resource "huaweicloud_rds_instance_restore" "test" {
+  instance_id = "target_instance_id"
+
+  source {
+    instance_id  = "source_instance_id"
+    backup_id    = "backup_id"
+    restore_time = "2023-02-09T00:00:00Z"
+  }
+}
+`
+}
@@ -2,6 +2,7 @@ package rds
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -129,6 +130,79 @@ func TestAccRdsInstance_ha(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstance_upgrade(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_upgrade(name, "12", "async", "02:00-04:00", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "db.0.version", "12"),
+					resource.TestCheckResourceAttrSet(resourceName, "db.0.minor_version"),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "async"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "02:00-04:00"),
+				),
+			},
+			{
+				// The major version upgrade can not be delayed to the maintenance window.
+				Config:      testAccRdsInstance_upgrade(name, "13", "sync", "18:00-22:00", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can not be changed in the maintenance window"),
+			},
+			{
+				Config: testAccRdsInstance_upgrade(name, "13", "sync", "18:00-22:00", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "db.0.version", "13"),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "sync"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window", "18:00-22:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRdsInstance_parameters(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_parameters(name, "200", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_restart_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_restart_parameters.0", "max_connections"),
+				),
+			},
+			{
+				Config: testAccRdsInstance_parameters(name, "300", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "pending_restart_parameters.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRdsInstance_mysql(t *testing.T) {
 	var instance instances.RdsInstanceResponse
 	name := acceptance.RandomAccResourceName()
@@ -236,6 +310,33 @@ func TestAccRdsInstance_prePaid(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstance_restore(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_restore(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-restore"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.instance_id",
+						"huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.backup_id",
+						"huaweicloud_rds_backup.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRdsInstanceDestroy(rsType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := acceptance.TestAccProvider.Meta().(*config.Config)
@@ -471,6 +572,74 @@ resource "huaweicloud_rds_instance" "test" {
 `, testAccRdsInstance_base(name), name)
 }
 
+func testAccRdsInstance_upgrade(name, version, replicationMode, maintenanceWindow string, switchInWindow bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_rds_instance" "test" {
+  name                         = "%[2]s"
+  flavor                       = "rds.pg.n1.large.2.ha"
+  security_group_id            = huaweicloud_networking_secgroup.test.id
+  subnet_id                    = huaweicloud_vpc_subnet.test.id
+  vpc_id                       = huaweicloud_vpc.test.id
+  ha_replication_mode          = "%[4]s"
+  maintenance_window           = "%[5]s"
+  switch_in_maintenance_window = %[6]t
+  change_private_ip_on_upgrade = true
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0],
+    data.huaweicloud_availability_zones.test.names[1],
+  ]
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "%[3]s"
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+`, testAccRdsInstance_base(name), name, version, replicationMode, maintenanceWindow, switchInWindow)
+}
+
+func testAccRdsInstance_parameters(name, maxConnections string, restart bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_rds_instance" "test" {
+  name                        = "%[2]s"
+  flavor                      = "rds.pg.n1.large.2"
+  availability_zone           = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id           = huaweicloud_networking_secgroup.test.id
+  subnet_id                   = huaweicloud_vpc_subnet.test.id
+  vpc_id                      = huaweicloud_vpc.test.id
+  restart_on_parameter_change = %[4]t
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+
+  parameters {
+    name  = "max_connections"
+    value = "%[3]s"
+  }
+  parameters {
+    name  = "lock_timeout"
+    value = "1000"
+  }
+}
+`, testAccRdsInstance_base(name), name, maxConnections, restart)
+}
+
 func testAccRdsInstance_mysql(name, pwd string) string {
 	return fmt.Sprintf(`
 %s
@@ -609,3 +778,35 @@ resource "huaweicloud_rds_instance" "test" {
 }
 `, testAccRdsInstance_base(name), name, pwd, isAutoRenew)
 }
+
+func testAccRdsInstance_restore(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_instance" "restore" {
+  name              = "%s-restore"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+  time_zone         = "UTC+08:00"
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+    port     = 8635
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+
+  restore {
+    instance_id = huaweicloud_rds_instance.test.id
+    backup_id   = huaweicloud_rds_backup.test.id
+  }
+}
+`, testBackup_basic(name), name)
+}
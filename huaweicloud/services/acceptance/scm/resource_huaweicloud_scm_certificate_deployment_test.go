@@ -0,0 +1,65 @@
+package scm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccScmCertificateDeployment_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_scm_certificate_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckScm(t)
+			acceptance.TestAccPreCheckScmCertificateDeployment(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScmCertificateDeployment_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate_id",
+						acceptance.HW_SCM_ISSUED_CERTIFICATE_ID),
+					resource.TestCheckResourceAttr(resourceName, "service", "ELB"),
+					resource.TestCheckResourceAttr(resourceName, "project_name", acceptance.HW_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_status", "ISSUED"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployed_not_after"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployed_not_after",
+						resourceName, "certificate_not_after"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScmCertificateDeployment_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_elb_certificate" "test" {
+  name        = "%[1]s"
+  certificate = file("%[2]s")
+  private_key = file("%[3]s")
+
+  # The content of the certificate is replaced by the SCM deployment.
+  lifecycle {
+    ignore_changes = [certificate, private_key]
+  }
+}
+
+resource "huaweicloud_scm_certificate_deployment" "test" {
+  certificate_id = "%[4]s"
+  service        = "ELB"
+
+  resources {
+    id = huaweicloud_elb_certificate.test.id
+  }
+}
+`, name, acceptance.HW_CERTIFICATE_KEY_PATH, acceptance.HW_CERTIFICATE_PRIVATE_KEY_PATH,
+		acceptance.HW_SCM_ISSUED_CERTIFICATE_ID)
+}
@@ -0,0 +1,81 @@
+package scm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/scm/v3/certificates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccScmManagedCertificate_basic(t *testing.T) {
+	var certInfo certificates.CertificateEscrowInfo
+	resourceName := "huaweicloud_scm_managed_certificate.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&certInfo,
+		getSCMResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckHighCostAllow(t)
+			acceptance.TestAccPreCheckScmManagedCertificate(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScmManagedCertificate_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "domain", acceptance.HW_SCM_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "domain_type", "SINGLE_DOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "validation_method", "DNS"),
+					resource.TestCheckResourceAttr(resourceName, "dns_validation_records.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "order_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.0.record_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.0.record_value"),
+					resource.TestCheckResourceAttrPair("huaweicloud_dns_recordset.validation", "name",
+						resourceName, "dns_validation_records.0.record_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"period", "contact_name", "contact_phone", "contact_email", "company_name", "key_algorithm",
+					"cert_brand", "cert_type", "sans",
+				},
+			},
+		},
+	})
+}
+
+func testAccScmManagedCertificate_basic() string {
+	return fmt.Sprintf(`
+resource "huaweicloud_scm_managed_certificate" "test" {
+  cert_brand    = "GEOTRUST"
+  cert_type     = "DV_SSL_CERT"
+  domain_type   = "SINGLE_DOMAIN"
+  domain        = "%[1]s"
+  contact_name  = "terraform"
+  contact_phone = "13800000000"
+  contact_email = "terraform@example.com"
+}
+
+resource "huaweicloud_dns_recordset" "validation" {
+  zone_id = "%[2]s"
+  name    = huaweicloud_scm_managed_certificate.test.dns_validation_records[0].record_name
+  type    = huaweicloud_scm_managed_certificate.test.dns_validation_records[0].record_type
+  records = [huaweicloud_scm_managed_certificate.test.dns_validation_records[0].record_value]
+  ttl     = 300
+}
+`, acceptance.HW_SCM_DOMAIN_NAME, acceptance.HW_SCM_DNS_ZONE_ID)
+}
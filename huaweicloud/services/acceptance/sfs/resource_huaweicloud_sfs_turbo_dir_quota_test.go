@@ -0,0 +1,103 @@
+package sfs
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getTurboDirQuotaResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.SfsV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("sfs-turbo", "shares", state.Primary.Attributes["share_id"], "fs", "dir-quota")
+	getPath += "?path=" + url.QueryEscape(state.Primary.Attributes["path"])
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccTurboDirQuota_basic(t *testing.T) {
+	var quota interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_sfs_turbo_dir_quota.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&quota,
+		getTurboDirQuotaResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTurboDirQuota_basic(rName, 1024, 10000),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "share_id", "huaweicloud_sfs_turbo.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/tf_acc_dir"),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "inode", "10000"),
+					resource.TestCheckResourceAttrSet(resourceName, "used_capacity"),
+				),
+			},
+			{
+				Config: testAccTurboDirQuota_basic(rName, 2048, 20000),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "inode", "20000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTurboDirQuotaImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccTurboDirQuotaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s%s", rs.Primary.Attributes["share_id"], rs.Primary.Attributes["path"]), nil
+	}
+}
+
+func testAccTurboDirQuota_basic(rName string, capacity, inode int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_sfs_turbo_dir_quota" "test" {
+  share_id = huaweicloud_sfs_turbo.test.id
+  path     = "/tf_acc_dir"
+  capacity = %d
+  inode    = %d
+}
+`, testAccTurbo_base(rName), capacity, inode)
+}
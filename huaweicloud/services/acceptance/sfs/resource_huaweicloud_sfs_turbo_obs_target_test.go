@@ -0,0 +1,101 @@
+package sfs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getTurboObsTargetResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.SfsV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("sfs-turbo", "shares", state.Primary.Attributes["share_id"], "targets",
+		state.Primary.ID)
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccTurboObsTarget_basic(t *testing.T) {
+	var target interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_sfs_turbo_obs_target.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&target,
+		getTurboObsTargetResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckSfsTurboHpcShareId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTurboObsTarget_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "share_id", acceptance.HW_SFS_TURBO_HPC_SHARE_ID),
+					resource.TestCheckResourceAttr(resourceName, "file_system_path", "tf_acc_obs"),
+					resource.TestCheckResourceAttrPair(resourceName, "obs.0.bucket", "huaweicloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "obs.0.auto_export_policy.0.events.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccTurboSubResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"delete_data_in_file_system"},
+			},
+		},
+	})
+}
+
+func testAccTurboObsTarget_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_sfs_turbo_obs_target" "test" {
+  share_id         = "%[2]s"
+  file_system_path = "tf_acc_obs"
+
+  obs {
+    bucket   = huaweicloud_obs_bucket.test.bucket
+    endpoint = "obs.%[3]s.myhuaweicloud.com"
+
+    auto_export_policy {
+      events = ["NEW", "CHANGED", "DELETED"]
+    }
+  }
+
+  delete_data_in_file_system = true
+}
+`, rName, acceptance.HW_SFS_TURBO_HPC_SHARE_ID, acceptance.HW_REGION_NAME)
+}
@@ -0,0 +1,135 @@
+package sfs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getTurboPermRuleResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.SfsV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("sfs-turbo", "shares", state.Primary.Attributes["share_id"], "fs", "perm-rules",
+		state.Primary.ID)
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccTurboPermRule_basic(t *testing.T) {
+	var rule interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_sfs_turbo_perm_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&rule,
+		getTurboPermRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTurboPermRule_basic(rName, "rw", "no_root_squash"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "share_id", "huaweicloud_sfs_turbo.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_cidr", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "rw_type", "rw"),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "no_root_squash"),
+				),
+			},
+			{
+				Config: testAccTurboPermRule_basic(rName, "ro", "all_squash"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "rw_type", "ro"),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "all_squash"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTurboSubResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccTurboSubResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["share_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccTurbo_base(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  vpc_id = huaweicloud_vpc.test.id
+
+  name       = "%[1]s"
+  cidr       = cidrsubnet(huaweicloud_vpc.test.cidr, 4, 1)
+  gateway_ip = cidrhost(cidrsubnet(huaweicloud_vpc.test.cidr, 4, 1), 1)
+}
+
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_sfs_turbo" "test" {
+  vpc_id            = huaweicloud_vpc.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+
+  name        = "%[1]s"
+  size        = 500
+  share_proto = "NFS"
+}
+`, rName)
+}
+
+func testAccTurboPermRule_basic(rName, rwType, userType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_sfs_turbo_perm_rule" "test" {
+  share_id  = huaweicloud_sfs_turbo.test.id
+  ip_cidr   = "192.168.1.0/24"
+  rw_type   = "%s"
+  user_type = "%s"
+}
+`, testAccTurbo_base(rName), rwType, userType)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/chnsz/golangsdk/openstack/networking/v1/routetables"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 func getVpcRTBRouteResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
@@ -33,7 +34,7 @@ func getVpcRTBRouteResourceFunc(conf *config.Config, state *terraform.ResourceSt
 
 	var route *routetables.Route
 	for _, item := range routeTable.Routes {
-		if item.DestinationCIDR == destination {
+		if utils.IsSameCIDR(item.DestinationCIDR, destination) {
 			route = &item
 			break
 		}
@@ -126,6 +127,43 @@ func TestAccVpcRTBRoute_vip(t *testing.T) {
 	})
 }
 
+func TestAccVpcRTBRoute_ipv6(t *testing.T) {
+	var route routetables.Route
+	randName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_vpc_route.ipv6"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&route,
+		getVpcRTBRouteResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcRTBRoute_ipv6(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "vip"),
+					resource.TestCheckResourceAttr(resourceName, "destination", "2001:db8:a::/64"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "nexthop",
+						"${huaweicloud_networking_vip.test.ip_address}"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "route_table_id",
+						"${huaweicloud_vpc_route_table.test.id}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccVpcRTBRoute_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_vpc" "test1" {
@@ -189,3 +227,40 @@ resource "huaweicloud_vpc_route" "vip" {
 }
 `, rName, rName, rName)
 }
+
+func testAccVpcRTBRoute_ipv6(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "172.16.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  vpc_id      = huaweicloud_vpc.test.id
+  name        = "%[1]s"
+  cidr        = "172.16.0.0/24"
+  gateway_ip  = "172.16.0.1"
+  ipv6_enable = true
+}
+
+resource "huaweicloud_vpc_route_table" "test" {
+  name    = "%[1]s"
+  vpc_id  = huaweicloud_vpc.test.id
+  subnets = [huaweicloud_vpc_subnet.test.id]
+}
+
+resource "huaweicloud_networking_vip" "test" {
+  network_id = huaweicloud_vpc_subnet.test.id
+  ip_version = 6
+}
+
+resource "huaweicloud_vpc_route" "ipv6" {
+  vpc_id         = huaweicloud_vpc.test.id
+  route_table_id = huaweicloud_vpc_route_table.test.id
+  destination    = "2001:DB8:A::/64"
+  type           = "vip"
+  nexthop        = huaweicloud_networking_vip.test.ip_address
+  description    = "IPv6 route"
+}
+`, rName)
+}
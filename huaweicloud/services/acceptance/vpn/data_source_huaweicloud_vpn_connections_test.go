@@ -0,0 +1,52 @@
+package vpn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceConnections_basic(t *testing.T) {
+	var (
+		name           = acceptance.RandomAccResourceName()
+		dataSourceName = "data.huaweicloud_vpn_connections.test"
+		dc             = acceptance.InitDataSourceCheck(dataSourceName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConnections_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connections.0.id",
+						"huaweicloud_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.0.vpn_type", "bgp"),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.0.ha_role", "master"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connections.0.status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connections.0.health_check_status",
+						"huaweicloud_vpn_connection_health_check.test", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConnections_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpn_connections" "test" {
+  gateway_id    = huaweicloud_vpn_gateway.test.id
+  connection_id = huaweicloud_vpn_connection.test.id
+
+  depends_on = [huaweicloud_vpn_connection_health_check.test]
+}
+`, testConnectionHealthCheck_basic(name))
+}
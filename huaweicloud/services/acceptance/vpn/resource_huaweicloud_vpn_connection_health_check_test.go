@@ -0,0 +1,71 @@
+package vpn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpn"
+)
+
+func getConnectionHealthCheckResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("vpn", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPN client: %s", err)
+	}
+	return vpn.GetConnectionHealthCheck(client, state.Primary.ID)
+}
+
+func TestAccConnectionHealthCheck_basic(t *testing.T) {
+	var (
+		obj interface{}
+
+		name  = acceptance.RandomAccResourceName()
+		rName = "huaweicloud_vpn_connection_health_check.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getConnectionHealthCheckResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnectionHealthCheck_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "connection_id", "huaweicloud_vpn_connection.test", "id"),
+					resource.TestCheckResourceAttrSet(rName, "type"),
+					resource.TestCheckResourceAttrSet(rName, "source_ip"),
+					resource.TestCheckResourceAttrSet(rName, "destination_ip"),
+					resource.TestCheckResourceAttrSet(rName, "proto_type"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testConnectionHealthCheck_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpn_connection_health_check" "test" {
+  connection_id = huaweicloud_vpn_connection.test.id
+}
+`, testConnection_bgp(name))
+}
@@ -150,3 +150,96 @@ resource "huaweicloud_vpn_connection" "test" {
 }
 `, testGateway_basic(name), testCustomerGateway_basic(name), name)
 }
+
+func TestAccConnection_bgp(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_vpn_connection.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getConnectionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConnection_bgp(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "vpn_type", "BGP"),
+					resource.TestCheckResourceAttr(rName, "ha_role", "master"),
+					resource.TestCheckResourceAttr(rName, "enable_nqa", "true"),
+					resource.TestCheckResourceAttr(rName, "tunnel_local_address", "169.254.56.225/30"),
+					resource.TestCheckResourceAttr(rName, "tunnel_peer_address", "169.254.56.226/30"),
+					resource.TestCheckResourceAttr("huaweicloud_vpn_gateway.test", "ha_mode", "active-standby"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"psk",
+				},
+			},
+		},
+	})
+}
+
+func testConnection_bgp(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["cn-north-4a", "cn-north-4b"]
+
+  name = "%[2]s"
+  asn  = 64512
+}
+
+resource "huaweicloud_vpn_gateway" "test" {
+  name               = "%[2]s"
+  attachment_type    = "er"
+  er_id              = huaweicloud_er_instance.test.id
+  access_vpc_id      = huaweicloud_vpc.test.id
+  access_subnet_id   = huaweicloud_vpc_subnet.test.id
+  availability_zones = ["cn-north-4a", "cn-north-4b"]
+  ha_mode            = "active-standby"
+  asn                = 64513
+
+  master_eip {
+    id = huaweicloud_vpc_eip.test1.id
+  }
+
+  slave_eip {
+    id = huaweicloud_vpc_eip.test2.id
+  }
+}
+
+resource "huaweicloud_vpn_customer_gateway" "test" {
+  name = "%[2]s"
+  ip   = "172.16.1.1"
+  asn  = 65000
+}
+
+resource "huaweicloud_vpn_connection" "test" {
+  name                 = "%[2]s"
+  gateway_id           = huaweicloud_vpn_gateway.test.id
+  gateway_ip           = huaweicloud_vpn_gateway.test.master_eip[0].id
+  customer_gateway_id  = huaweicloud_vpn_customer_gateway.test.id
+  vpn_type             = "bgp"
+  psk                  = "Test@123"
+  tunnel_local_address = "169.254.56.225/30"
+  tunnel_peer_address  = "169.254.56.226/30"
+  ha_role              = "master"
+  enable_nqa           = true
+}
+`, testGateway_base(name), name)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -93,6 +94,48 @@ func TestAccGateway_basic(t *testing.T) {
 	})
 }
 
+func TestAccGateway_er(t *testing.T) {
+	var (
+		obj interface{}
+
+		name     = acceptance.RandomAccResourceName()
+		rName    = "huaweicloud_vpn_gateway.test"
+		bgpAsNum = acctest.RandIntRange(64512, 65534)
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGatewayResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGateway_er(name, bgpAsNum),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "attachment_type", "er"),
+					resource.TestCheckResourceAttrPair(rName, "er_id", "huaweicloud_er_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "access_vpc_id", "huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "access_subnet_id", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(rName, "er_attachment_id"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testGateway_base(name string) string {
 	return fmt.Sprintf(`
 resource "huaweicloud_vpc" "test" {
@@ -176,3 +219,33 @@ resource "huaweicloud_vpn_gateway" "test" {
 }
 `, testGateway_base(name), name)
 }
+
+func testGateway_er(name string, bgpAsNum int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_er_instance" "test" {
+  availability_zones = ["cn-north-4a", "cn-north-4b"]
+
+  name = "%[2]s"
+  asn  = %[3]d
+}
+
+resource "huaweicloud_vpn_gateway" "test" {
+  name               = "%[2]s"
+  attachment_type    = "er"
+  er_id              = huaweicloud_er_instance.test.id
+  access_vpc_id      = huaweicloud_vpc.test.id
+  access_subnet_id   = huaweicloud_vpc_subnet.test.id
+  availability_zones = ["cn-north-4a", "cn-north-4b"]
+
+  master_eip {
+    id = huaweicloud_vpc_eip.test1.id
+  }
+
+  slave_eip {
+    id = huaweicloud_vpc_eip.test2.id
+  }
+}
+`, testGateway_base(name), name, bgpAsNum)
+}
@@ -0,0 +1,86 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRuleAntiCrawler_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_anti_crawler.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("anticrawler"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleAntiCrawler_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "path", "/api"),
+					resource.TestCheckResourceAttr(resourceName, "logic", "prefix"),
+				),
+			},
+			{
+				Config: testAccWafRuleAntiCrawler_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/api/v2"),
+					resource.TestCheckResourceAttr(resourceName, "logic", "equal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRuleAntiCrawler_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_anti_crawler" "test" {
+  policy_id       = huaweicloud_waf_policy.policy_1.id
+  name            = "%[2]s"
+  protection_mode = "anticrawler_specific_url"
+  path            = "/api"
+  logic           = "prefix"
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRuleAntiCrawler_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_anti_crawler" "test" {
+  policy_id       = huaweicloud_waf_policy.policy_1.id
+  name            = "%[2]s_update"
+  protection_mode = "anticrawler_specific_url"
+  path            = "/api/v2"
+  logic           = "equal"
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
@@ -0,0 +1,112 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRuleCCProtection_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_cc_protection.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("cc"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleCCProtection_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "protective_action", "block"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit_mode", "cookie"),
+					resource.TestCheckResourceAttr(resourceName, "limit_num", "10"),
+					resource.TestCheckResourceAttr(resourceName, "url", "/abc"),
+				),
+			},
+			{
+				Config: testAccWafRuleCCProtection_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "protective_action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit_mode", "ip"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.1.subfield", "key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRuleCCProtection_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_cc_protection" "test" {
+  policy_id         = huaweicloud_waf_policy.policy_1.id
+  name              = "%[2]s"
+  protective_action = "block"
+  rate_limit_mode   = "cookie"
+  user_identifier   = "sessionid"
+  limit_num         = 10
+  limit_period      = 60
+  lock_time         = 10
+  url               = "/abc"
+  block_page_type   = "application/json"
+  page_content      = "{\"error\":\"too many requests\"}"
+  description       = "test description"
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRuleCCProtection_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_cc_protection" "test" {
+  policy_id         = huaweicloud_waf_policy.policy_1.id
+  name              = "%[2]s_update"
+  protective_action = "log"
+  rate_limit_mode   = "ip"
+  limit_num         = 20
+  limit_period      = 30
+  description       = ""
+
+  conditions {
+    field   = "url"
+    logic   = "contain"
+    content = ["/abc"]
+  }
+
+  conditions {
+    field    = "params"
+    subfield = "key"
+    logic    = "equal"
+    content  = ["value"]
+  }
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
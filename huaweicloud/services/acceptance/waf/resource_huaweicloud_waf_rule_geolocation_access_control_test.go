@@ -0,0 +1,85 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRuleGeolocation_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_geolocation_access_control.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("geoip"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleGeolocation_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "geolocation", "BJ|SH"),
+					resource.TestCheckResourceAttr(resourceName, "action", "0"),
+				),
+			},
+			{
+				Config: testAccWafRuleGeolocation_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "geolocation", "GD"),
+					resource.TestCheckResourceAttr(resourceName, "action", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRuleGeolocation_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_geolocation_access_control" "test" {
+  policy_id   = huaweicloud_waf_policy.policy_1.id
+  name        = "%[2]s"
+  geolocation = "BJ|SH"
+  action      = 0
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRuleGeolocation_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_geolocation_access_control" "test" {
+  policy_id   = huaweicloud_waf_policy.policy_1.id
+  name        = "%[2]s_update"
+  geolocation = "GD"
+  action      = 2
+  description = "test description"
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
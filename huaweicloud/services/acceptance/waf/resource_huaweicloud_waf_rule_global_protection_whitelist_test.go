@@ -0,0 +1,98 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRuleGlobalProtectionWhitelist_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_global_protection_whitelist.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("ignore"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleGlobalProtectionWhitelist_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_waf_protection", "all"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.field", "url"),
+				),
+			},
+			{
+				Config: testAccWafRuleGlobalProtectionWhitelist_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_waf_protection", "xss"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_field", "params"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.field", "ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRuleGlobalProtectionWhitelist_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_global_protection_whitelist" "test" {
+  policy_id             = huaweicloud_waf_policy.policy_1.id
+  domains               = ["www.example.com"]
+  ignore_waf_protection = "all"
+
+  conditions {
+    field   = "url"
+    logic   = "equal"
+    content = ["/admin"]
+  }
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRuleGlobalProtectionWhitelist_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_global_protection_whitelist" "test" {
+  policy_id             = huaweicloud_waf_policy.policy_1.id
+  domains               = ["www.example.com", "www.example.org"]
+  ignore_waf_protection = "xss"
+  advanced_field        = "params"
+  advanced_content      = ["q"]
+  description           = "test description"
+
+  conditions {
+    field   = "ip"
+    logic   = "equal"
+    content = ["192.168.0.1"]
+  }
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
@@ -0,0 +1,86 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRuleLeakagePrevention_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_information_leakage_prevention.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("antileakage"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRuleLeakagePrevention_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "sensitive"),
+					resource.TestCheckResourceAttr(resourceName, "contents.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protective_action", "log"),
+				),
+			},
+			{
+				Config: testAccWafRuleLeakagePrevention_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "path", "/test/update"),
+					resource.TestCheckResourceAttr(resourceName, "type", "code"),
+					resource.TestCheckResourceAttr(resourceName, "protective_action", "block"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRuleLeakagePrevention_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_information_leakage_prevention" "test" {
+  policy_id = huaweicloud_waf_policy.policy_1.id
+  path      = "/test"
+  type      = "sensitive"
+  contents  = ["id_card", "phone"]
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRuleLeakagePrevention_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_information_leakage_prevention" "test" {
+  policy_id         = huaweicloud_waf_policy.policy_1.id
+  path              = "/test/update"
+  type              = "code"
+  contents          = ["400", "500"]
+  protective_action = "block"
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
@@ -0,0 +1,109 @@
+package waf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccWafRulePreciseProtection_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_waf_rule_precise_protection.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWafRuleFunc("custom"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckWafInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWafRulePreciseProtection_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "huaweicloud_waf_policy.policy_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "action", "block"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2030-01-01 00:00:00"),
+				),
+			},
+			{
+				Config: testAccWafRulePreciseProtection_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWafRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWafRulePreciseProtection_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_precise_protection" "test" {
+  policy_id   = huaweicloud_waf_policy.policy_1.id
+  name        = "%[2]s"
+  priority    = 10
+  action      = "block"
+  start_time  = "2030-01-01 00:00:00"
+  end_time    = "2030-12-31 23:59:59"
+  description = "test description"
+
+  conditions {
+    field   = "url"
+    logic   = "prefix"
+    content = ["/login"]
+  }
+
+  conditions {
+    field   = "method"
+    logic   = "equal"
+    content = ["POST"]
+  }
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
+
+func testAccWafRulePreciseProtection_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_waf_rule_precise_protection" "test" {
+  policy_id = huaweicloud_waf_policy.policy_1.id
+  name      = "%[2]s_update"
+  priority  = 20
+  action    = "log"
+
+  conditions {
+    field   = "user-agent"
+    logic   = "contain"
+    content = ["curl"]
+  }
+}
+`, testAccWafPolicyV1_basic(name), name)
+}
@@ -0,0 +1,28 @@
+package waf
+
+import (
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+// getWafRuleFunc returns the function to query the WAF rule of the rule type, it is shared by the rule tests which
+// use the generic requests.
+func getWafRuleFunc(ruleType string) acceptance.ServiceFunc {
+	return func(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+		client, err := cfg.WafV1Client(acceptance.HW_REGION_NAME)
+		if err != nil {
+			return nil, fmt.Errorf("error creating WAF client: %s", err)
+		}
+
+		getPath := client.ServiceURL("policy", state.Primary.Attributes["policy_id"], ruleType, state.Primary.ID)
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		}
+		return client.Request("GET", getPath, &getOpt)
+	}
+}
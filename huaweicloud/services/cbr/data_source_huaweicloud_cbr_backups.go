@@ -0,0 +1,270 @@
+package cbr
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceBackups is the impl for huaweicloud_cbr_backups data source, which also exposes the replication records
+// of the backups replicated to other regions.
+func DataSourceBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checkpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ResourceTypeServer, ResourceTypeDisk, ResourceTypeTurbo,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"show_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checkpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vault_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_az": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination_backup_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination_vault_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"created_at": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildBackupsQueryParams(d *schema.ResourceData) string {
+	params := url.Values{}
+	for _, key := range []string{
+		"vault_id", "checkpoint_id", "resource_id", "resource_type", "name", "status", "start_time", "end_time",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params.Set(key, v.(string))
+		}
+	}
+	if d.Get("show_replication").(bool) {
+		params.Set("show_replication", "true")
+	}
+	return params.Encode()
+}
+
+func flattenBackupReplicationRecords(records []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		result = append(result, map[string]interface{}{
+			"id":                    utils.PathSearch("id", record, nil),
+			"source_region":         utils.PathSearch("source_region", record, nil),
+			"destination_region":    utils.PathSearch("destination_region", record, nil),
+			"destination_backup_id": utils.PathSearch("destination_backup_id", record, nil),
+			"destination_vault_id":  utils.PathSearch("destination_vault_id", record, nil),
+			"status":                utils.PathSearch("status", record, nil),
+			"created_at":            utils.PathSearch("created_at", record, nil),
+		})
+	}
+	return result
+}
+
+func flattenBackups(d *schema.ResourceData, backups []interface{}) []map[string]interface{} {
+	destRegion := d.Get("destination_region").(string)
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		records := utils.PathSearch("replication_records", backup, make([]interface{}, 0)).([]interface{})
+		// Only the backups replicated to the destination region are returned if the region is specified.
+		if destRegion != "" && utils.PathSearch("[?destination_region=='"+destRegion+"']|[0]", records, nil) == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                  utils.PathSearch("id", backup, nil),
+			"name":                utils.PathSearch("name", backup, nil),
+			"description":         utils.PathSearch("description", backup, nil),
+			"checkpoint_id":       utils.PathSearch("checkpoint_id", backup, nil),
+			"vault_id":            utils.PathSearch("vault_id", backup, nil),
+			"resource_id":         utils.PathSearch("resource_id", backup, nil),
+			"resource_name":       utils.PathSearch("resource_name", backup, nil),
+			"resource_type":       utils.PathSearch("resource_type", backup, nil),
+			"resource_size":       utils.PathSearch("resource_size", backup, nil),
+			"resource_az":         utils.PathSearch("resource_az", backup, nil),
+			"image_type":          utils.PathSearch("image_type", backup, nil),
+			"status":              utils.PathSearch("status", backup, nil),
+			"created_at":          utils.PathSearch("created_at", backup, nil),
+			"updated_at":          utils.PathSearch("updated_at", backup, nil),
+			"expired_at":          utils.PathSearch("expired_at", backup, nil),
+			"replication_records": flattenBackupReplicationRecords(records),
+		})
+	}
+	return result
+}
+
+func dataSourceBackupsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	backups, err := listBackups(client, buildBackupsQueryParams(d))
+	if err != nil {
+		return diag.Errorf("error retrieving CBR backups: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("backups", flattenBackups(d, backups)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CBR backups fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package cbr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceBackup is the impl for huaweicloud_cbr_backup resource, which creates an on-demand checkpoint (restore point)
+// of the vault. A backup is generated for each resource contained in the checkpoint.
+func ResourceBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupCreate,
+		ReadContext:   resourceBackupRead,
+		DeleteContext: resourceBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+			"resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildBackupCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	parameters := map[string]interface{}{
+		"auto_trigger": false,
+		"incremental":  d.Get("incremental"),
+		"name":         utils.ValueIngoreEmpty(d.Get("name")),
+		"description":  utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	// All resources of the vault are backed up if the resources are omitted.
+	if resources := d.Get("resources").(*schema.Set).List(); len(resources) > 0 {
+		parameters["resources"] = resources
+	}
+	return map[string]interface{}{
+		"checkpoint": map[string]interface{}{
+			"vault_id":   d.Get("vault_id"),
+			"parameters": utils.RemoveNil(parameters),
+		},
+	}
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CbrV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         buildBackupCreateBodyParams(d),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("checkpoints"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CBR backup: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	checkpointId := utils.PathSearch("checkpoint.id", respBody, "").(string)
+	if checkpointId == "" {
+		return diag.Errorf("error creating CBR backup: ID is not found in API response")
+	}
+	d.SetId(checkpointId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"protecting"},
+		Target:       []string{"available"},
+		Refresh:      checkpointStateRefreshFunc(client, checkpointId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CBR backup (%s) to be available: %s", checkpointId, err)
+	}
+	return resourceBackupRead(ctx, d, meta)
+}
+
+// GetCheckpoint is a method to query the checkpoint (restore point) by its ID.
+func GetCheckpoint(client *golangsdk.ServiceClient, checkpointId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("checkpoints", checkpointId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("checkpoint", respBody, nil), nil
+}
+
+func checkpointStateRefreshFunc(client *golangsdk.ServiceClient, checkpointId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		checkpoint, err := GetCheckpoint(client, checkpointId)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		status := utils.PathSearch("status", checkpoint, "").(string)
+		if strings.HasPrefix(status, "error") {
+			return checkpoint, status, fmt.Errorf("unexpected status: %s", status)
+		}
+		return checkpoint, status, nil
+	}
+}
+
+// listBackups returns all backups which match the query parameters, e.g. "checkpoint_id=xxx&vault_id=xxx".
+func listBackups(client *golangsdk.ServiceClient, queryParams string) ([]interface{}, error) {
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	listPath := client.ServiceURL("backups") + "?limit=100"
+	if queryParams != "" {
+		listPath += "&" + queryParams
+	}
+
+	result := make([]interface{}, 0)
+	for offset := 0; ; {
+		resp, err := client.Request("GET", fmt.Sprintf("%s&offset=%d", listPath, offset), &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		backups := utils.PathSearch("backups", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, backups...)
+		if len(backups) < 100 {
+			break
+		}
+		offset += len(backups)
+	}
+	return result, nil
+}
+
+func flattenCheckpointBackups(backups []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		result = append(result, map[string]interface{}{
+			"id":            utils.PathSearch("id", backup, nil),
+			"name":          utils.PathSearch("name", backup, nil),
+			"resource_id":   utils.PathSearch("resource_id", backup, nil),
+			"resource_type": utils.PathSearch("resource_type", backup, nil),
+			"resource_size": utils.PathSearch("resource_size", backup, nil),
+			"status":        utils.PathSearch("status", backup, nil),
+			"created_at":    utils.PathSearch("created_at", backup, nil),
+			"expired_at":    utils.PathSearch("expired_at", backup, nil),
+		})
+	}
+	return result
+}
+
+func resourceBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	checkpoint, err := GetCheckpoint(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBR backup")
+	}
+	// The checkpoint is still queryable for a while after all backups are deleted.
+	status := utils.PathSearch("status", checkpoint, "").(string)
+	if status == "deleted" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "CBR backup")
+	}
+
+	backups, err := listBackups(client, "checkpoint_id="+d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving backups of the checkpoint (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vault_id", utils.PathSearch("vault.id", checkpoint, nil)),
+		d.Set("name", utils.PathSearch("extra_info.name", checkpoint, nil)),
+		d.Set("description", utils.PathSearch("extra_info.description", checkpoint, nil)),
+		d.Set("resources", utils.PathSearch("vault.resources[*].id", checkpoint, nil)),
+		d.Set("status", status),
+		d.Set("created_at", utils.PathSearch("created_at", checkpoint, nil)),
+		d.Set("backups", flattenCheckpointBackups(backups)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CBR backup fields: %s", err)
+	}
+	return nil
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CbrV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	// The checkpoint cannot be deleted directly, it is deleted with the last backup.
+	backups, err := listBackups(client, "checkpoint_id="+d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving backups of the checkpoint (%s): %s", d.Id(), err)
+	}
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+	}
+	for _, backup := range backups {
+		backupId := utils.PathSearch("id", backup, "").(string)
+		_, err = client.Request("DELETE", client.ServiceURL("backups", backupId), &deleteOpt)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return diag.Errorf("error deleting backup (%s) of the checkpoint (%s): %s", backupId, d.Id(), err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			remaining, err := listBackups(client, "checkpoint_id="+d.Id())
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if len(remaining) > 0 {
+				return remaining, "PENDING", nil
+			}
+			return remaining, "DELETED", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CBR backup (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package cbr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRestore is the impl for huaweicloud_cbr_restore resource, which restores a server, disk or SFS Turbo backup
+// to the target resource. The data of the target resource is overwritten.
+func ResourceRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRestoreCreate,
+		ReadContext:   resourceRestoreRead,
+		DeleteContext: resourceRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"volume_id", "resource_id"},
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"mappings": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"server_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"power_on": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+				ForceNew:     true,
+				RequiredWith: []string{"server_id"},
+			},
+			"destination_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"resource_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildRestoreMappings(mappings []interface{}) []map[string]interface{} {
+	if len(mappings) < 1 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(mappings))
+	for _, v := range mappings {
+		mapping := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"backup_id": mapping["backup_id"],
+			"volume_id": mapping["volume_id"],
+		})
+	}
+	return result
+}
+
+func buildRestoreBodyParams(d *schema.ResourceData) map[string]interface{} {
+	restore := map[string]interface{}{
+		"server_id":   utils.ValueIngoreEmpty(d.Get("server_id")),
+		"volume_id":   utils.ValueIngoreEmpty(d.Get("volume_id")),
+		"resource_id": utils.ValueIngoreEmpty(d.Get("resource_id")),
+		"mappings":    buildRestoreMappings(d.Get("mappings").([]interface{})),
+	}
+	if _, ok := d.GetOk("server_id"); ok {
+		restore["power_on"] = d.Get("power_on")
+	}
+	if path, ok := d.GetOk("destination_path"); ok {
+		restore["details"] = map[string]interface{}{
+			"destination_path": path,
+		}
+	}
+	return map[string]interface{}{
+		"restore": utils.RemoveNil(restore),
+	}
+}
+
+func resourceRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CbrV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	backupId := d.Get("backup_id").(string)
+	restoreOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody:         buildRestoreBodyParams(d),
+	}
+	if _, err = client.Request("POST", client.ServiceURL("backups", backupId, "restore"), &restoreOpt); err != nil {
+		return diag.Errorf("error restoring CBR backup (%s): %s", backupId, err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	// The backup is in the restoring status until the restoration is finished.
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"restoring"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			backup, err := GetBackup(client, backupId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", backup, "").(string)
+			if status == "error" {
+				return backup, status, fmt.Errorf("unexpected status: %s", status)
+			}
+			return backup, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        20 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CBR backup (%s) restoration to be finished: %s", backupId, err)
+	}
+	if err = d.Set("status", "success"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceRestoreRead(ctx, d, meta)
+}
+
+// GetBackup is a method to query the backup by its ID.
+func GetBackup(client *golangsdk.ServiceClient, backupId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("backups", backupId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("backup", respBody, nil), nil
+}
+
+func resourceRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR v3 client: %s", err)
+	}
+
+	// The restoration is removed from the state if the backup is deleted.
+	if _, err = GetBackup(client, d.Get("backup_id").(string)); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBR backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CBR restore fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRestoreDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the CBR restore is not supported. The restore is only removed from the state, " +
+		"the restored data remains in the target resource."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package cfw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const cfwListPageLimit = 1024
+
+// listCfwRecords queries all pages of the CFW list API, the records of each page are in data.records and the total
+// count is in data.total.
+func listCfwRecords(client *golangsdk.ServiceClient, listPath string) ([]interface{}, error) {
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+
+	var (
+		result []interface{}
+		offset = 0
+	)
+	for {
+		currentPath := fmt.Sprintf("%s&limit=%d&offset=%d", listPath, cfwListPageLimit, offset)
+		resp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		records := utils.PathSearch("data.records", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, records...)
+		total := int(utils.PathSearch("data.total", respBody, float64(0)).(float64))
+		if len(records) == 0 || len(result) >= total {
+			return result, nil
+		}
+		offset += len(records)
+	}
+}
+
+// filterCfwRecord returns the record whose key equals to the ID, golangsdk.ErrDefault404 is returned if not found.
+func filterCfwRecord(records []interface{}, key, id string) (interface{}, error) {
+	for _, record := range records {
+		if utils.PathSearch(key, record, "").(string) == id {
+			return record, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+// isCfwParamConfigured checks whether the optional parameter is specified in the configuration, it is used by the
+// parameters whose zero value (e.g. false) is meaningful.
+func isCfwParamConfigured(d *schema.ResourceData, key string) bool {
+	return !d.GetRawConfig().GetAttr(key).IsNull()
+}
+
+// resourceCfwParentImportState imports the resources which belong to the parent resource (e.g. the protected object
+// or the group), the format of the import ID is <parent ID>/<ID>.
+func resourceCfwParentImportState(parentKey string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.SplitN(d.Id(), "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid format specified for import id, must be <%s>/<id>", parentKey)
+		}
+
+		d.SetId(parts[1])
+		return []*schema.ResourceData{d}, d.Set(parentKey, parts[0])
+	}
+}
@@ -0,0 +1,196 @@
+package cfw
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceAddressGroup is the impl of huaweicloud_cfw_address_group, the IP address group (address set) can be
+// referenced by the protection rules and the members are managed by huaweicloud_cfw_address_group_member.
+func ResourceAddressGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAddressGroupCreate,
+		UpdateContext: resourceAddressGroupUpdate,
+		ReadContext:   resourceAddressGroupRead,
+		DeleteContext: resourceAddressGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCfwParentImportState("object_id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The protected object ID.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The name of the IP address group.`,
+			},
+			"address_type": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				Description:  `The address type, 0 (IPv4) or 1 (IPv6).`,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the IP address group.`,
+			},
+		},
+	}
+}
+
+func resourceAddressGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v1/{project_id}/address-set"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"object_id":    d.Get("object_id"),
+			"name":         d.Get("name"),
+			"address_type": d.Get("address_type"),
+			"description":  utils.ValueIngoreEmpty(d.Get("description")),
+		}),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CFW address group: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("data.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating CFW address group: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	return resourceAddressGroupRead(ctx, d, meta)
+}
+
+func resourceAddressGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cfw", region)
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v1/{project_id}/address-sets/{id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW address group")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := utils.PathSearch("data", respBody, nil)
+	if group == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving CFW address group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", group, nil)),
+		d.Set("address_type", utils.PathSearch("address_type", group, nil)),
+		d.Set("description", utils.PathSearch("description", group, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CFW address group fields: %s", err)
+	}
+	return nil
+}
+
+func resourceAddressGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v1/{project_id}/address-sets/{id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"name":        d.Get("name"),
+			"description": d.Get("description"),
+		},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating CFW address group: %s", err)
+	}
+
+	return resourceAddressGroupRead(ctx, d, meta)
+}
+
+func resourceAddressGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v1/{project_id}/address-sets/{id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CFW address group")
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package cfw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceAddressGroupMember is the impl of huaweicloud_cfw_address_group_member, the members can not be updated,
+// so all parameters are ForceNew.
+func ResourceAddressGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAddressGroupMemberCreate,
+		ReadContext:   resourceAddressGroupMemberRead,
+		DeleteContext: resourceAddressGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCfwParentImportState("group_id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the IP address group.`,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The IP address, IP address range or CIDR block.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The name of the member.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The description of the member.`,
+			},
+		},
+	}
+}
+
+func resourceAddressGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v1/{project_id}/address-items"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"set_id": d.Get("group_id"),
+			"address_items": []map[string]interface{}{
+				utils.RemoveNil(map[string]interface{}{
+					"address":     d.Get("address"),
+					"name":        utils.ValueIngoreEmpty(d.Get("name")),
+					"description": utils.ValueIngoreEmpty(d.Get("description")),
+				}),
+			},
+		},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CFW address group member: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("data.items[0].id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating CFW address group member: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	return resourceAddressGroupMemberRead(ctx, d, meta)
+}
+
+func resourceAddressGroupMemberRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cfw", region)
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v1/{project_id}/address-items"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath += fmt.Sprintf("?set_id=%s", d.Get("group_id"))
+	members, err := listCfwRecords(client, listPath)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW address group members")
+	}
+	member, err := filterCfwRecord(members, "item_id", d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW address group member")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("address", utils.PathSearch("address", member, nil)),
+		d.Set("name", utils.PathSearch("name", member, nil)),
+		d.Set("description", utils.PathSearch("description", member, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CFW address group member fields: %s", err)
+	}
+	return nil
+}
+
+func resourceAddressGroupMemberDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v1/{project_id}/address-items/{id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CFW address group member")
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package cfw
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceBlackWhiteList is the impl of huaweicloud_cfw_black_white_list, the traffic matching the blacklist is
+// blocked and the traffic matching the whitelist is allowed without being checked by the protection rules.
+func ResourceBlackWhiteList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBlackWhiteListCreate,
+		UpdateContext: resourceBlackWhiteListUpdate,
+		ReadContext:   resourceBlackWhiteListRead,
+		DeleteContext: resourceBlackWhiteListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBlackWhiteListImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The protected object ID.`,
+			},
+			"list_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  `The list type, 4 (blacklist) or 5 (whitelist).`,
+				ValidateFunc: validation.IntInSlice([]int{4, 5}),
+			},
+			"direction": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `The address direction, 0 (source address) or 1 (destination address).`,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"address_type": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  `The address type, 0 (IPv4) or 1 (IPv6).`,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The IP address or CIDR block.`,
+			},
+			"protocol": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `The protocol type, 6 (TCP), 17 (UDP), 1 (ICMP) or -1 (any).`,
+				ValidateFunc: validation.IntInSlice([]int{-1, 1, 6, 17}),
+			},
+			"port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The destination port or port range.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the list.`,
+			},
+		},
+	}
+}
+
+func buildBlackWhiteListBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"list_type":    d.Get("list_type"),
+		"direction":    d.Get("direction"),
+		"address_type": d.Get("address_type"),
+		"address":      d.Get("address"),
+		"protocol":     d.Get("protocol"),
+		"port":         utils.ValueIngoreEmpty(d.Get("port")),
+		"description":  d.Get("description"),
+	}
+}
+
+func resourceBlackWhiteListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v1/{project_id}/black-white-list"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	bodyParams := buildBlackWhiteListBodyParams(d)
+	bodyParams["object_id"] = d.Get("object_id")
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(bodyParams),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CFW black white list: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("data.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating CFW black white list: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	return resourceBlackWhiteListRead(ctx, d, meta)
+}
+
+func resourceBlackWhiteListRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cfw", region)
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v1/{project_id}/black-white-lists"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath += fmt.Sprintf("?object_id=%s&list_type=%d", d.Get("object_id"), d.Get("list_type"))
+	records, err := listCfwRecords(client, listPath)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW black white lists")
+	}
+	record, err := filterCfwRecord(records, "list_id", d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW black white list")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("direction", utils.PathSearch("direction", record, nil)),
+		d.Set("address_type", utils.PathSearch("address_type", record, nil)),
+		d.Set("address", utils.PathSearch("address", record, nil)),
+		d.Set("protocol", utils.PathSearch("protocol", record, nil)),
+		d.Set("port", utils.PathSearch("port", record, nil)),
+		d.Set("description", utils.PathSearch("description", record, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CFW black white list fields: %s", err)
+	}
+	return nil
+}
+
+func resourceBlackWhiteListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v1/{project_id}/black-white-list/{id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(buildBlackWhiteListBodyParams(d)),
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating CFW black white list: %s", err)
+	}
+
+	return resourceBlackWhiteListRead(ctx, d, meta)
+}
+
+func resourceBlackWhiteListDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v1/{project_id}/black-white-list/{id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CFW black white list")
+	}
+	return nil
+}
+
+func resourceBlackWhiteListImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format specified for import id, must be <object_id>/<list_type>/<id>")
+	}
+	listType, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid list_type specified for import id, it must be a number: %s", err)
+	}
+
+	d.SetId(parts[2])
+	mErr := multierror.Append(nil,
+		d.Set("object_id", parts[0]),
+		d.Set("list_type", listType),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,211 @@
+package cfw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	eipProtectionStatusEnabled  = 0
+	eipProtectionStatusDisabled = 1
+)
+
+// ResourceEipProtection is the impl of huaweicloud_cfw_eip_protection, which enables the protection of the EIPs for
+// the protected object, the resource ID is the protected object ID. Only the EIPs managed by the resource are
+// checked, and the protection of them is disabled when the resource is destroyed.
+func ResourceEipProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEipProtectionCreate,
+		UpdateContext: resourceEipProtectionUpdate,
+		ReadContext:   resourceEipProtectionRead,
+		DeleteContext: resourceEipProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The protected object ID.`,
+			},
+			"protected_eip": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: `The EIPs to be protected.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The EIP ID.`,
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The public IP address of the EIP.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func updateEipProtectionStatus(client *golangsdk.ServiceClient, objectId string, eips []interface{},
+	status int) error {
+	if len(eips) == 0 {
+		return nil
+	}
+
+	ipInfos := make([]map[string]interface{}, 0, len(eips))
+	for _, v := range eips {
+		eip := v.(map[string]interface{})
+		ipInfos = append(ipInfos, map[string]interface{}{
+			"id":        eip["id"],
+			"public_ip": eip["public_ip"],
+		})
+	}
+
+	updatePath := client.Endpoint + "v1/{project_id}/eip/protect"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"object_id": objectId,
+			"status":    status,
+			"ip_infos":  ipInfos,
+		},
+	}
+	_, err := client.Request("POST", updatePath, &updateOpt)
+	return err
+}
+
+func resourceEipProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	objectId := d.Get("object_id").(string)
+	eips := d.Get("protected_eip").(*schema.Set).List()
+	if err = updateEipProtectionStatus(client, objectId, eips, eipProtectionStatusEnabled); err != nil {
+		return diag.Errorf("error enabling CFW EIP protection: %s", err)
+	}
+	d.SetId(objectId)
+
+	return resourceEipProtectionRead(ctx, d, meta)
+}
+
+func resourceEipProtectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cfw", region)
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v1/{project_id}/eips/protect"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath += fmt.Sprintf("?object_id=%s", d.Id())
+	records, err := listCfwRecords(client, listPath)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CFW EIP protection")
+	}
+
+	protectedEips := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("protected_eip").(*schema.Set).List() {
+		eip := v.(map[string]interface{})
+		record, err := filterCfwRecord(records, "id", eip["id"].(string))
+		if err != nil {
+			continue
+		}
+		if int(utils.PathSearch("status", record, float64(eipProtectionStatusDisabled)).(float64)) ==
+			eipProtectionStatusEnabled {
+			protectedEips = append(protectedEips, map[string]interface{}{
+				"id":        eip["id"],
+				"public_ip": utils.PathSearch("public_ip", record, nil),
+			})
+		}
+	}
+	// No EIP is managed after the import, so all protected EIPs of the object are read.
+	if d.Get("protected_eip").(*schema.Set).Len() == 0 {
+		for _, record := range records {
+			if int(utils.PathSearch("status", record, float64(eipProtectionStatusDisabled)).(float64)) ==
+				eipProtectionStatusEnabled {
+				protectedEips = append(protectedEips, map[string]interface{}{
+					"id":        utils.PathSearch("id", record, nil),
+					"public_ip": utils.PathSearch("public_ip", record, nil),
+				})
+			}
+		}
+	}
+	if len(protectedEips) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving CFW EIP protection")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("object_id", d.Id()),
+		d.Set("protected_eip", protectedEips),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CFW EIP protection fields: %s", err)
+	}
+	return nil
+}
+
+func resourceEipProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("protected_eip")
+	removedEips := oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set)).List()
+	addedEips := newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).List()
+	if err = updateEipProtectionStatus(client, d.Id(), removedEips, eipProtectionStatusDisabled); err != nil {
+		return diag.Errorf("error disabling CFW EIP protection: %s", err)
+	}
+	if err = updateEipProtectionStatus(client, d.Id(), addedEips, eipProtectionStatusEnabled); err != nil {
+		return diag.Errorf("error enabling CFW EIP protection: %s", err)
+	}
+
+	return resourceEipProtectionRead(ctx, d, meta)
+}
+
+func resourceEipProtectionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	eips := d.Get("protected_eip").(*schema.Set).List()
+	if err = updateEipProtectionStatus(client, d.Id(), eips, eipProtectionStatusDisabled); err != nil {
+		return diag.Errorf("error disabling CFW EIP protection: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package cfw
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	ipsTypeBasicDefense   = 1
+	ipsTypeVirtualPatches = 2
+)
+
+// ResourceIpsAntivirusConfig is the impl of huaweicloud_cfw_ips_antivirus_config, which manages the intrusion
+// prevention (IPS) and the antivirus configurations of the protected object, the resource ID is the protected object
+// ID. Only the configured parameters are changed, and the configurations are kept when the resource is destroyed.
+func ResourceIpsAntivirusConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpsAntivirusConfigCreate,
+		UpdateContext: resourceIpsAntivirusConfigUpdate,
+		ReadContext:   resourceIpsAntivirusConfigRead,
+		DeleteContext: resourceIpsAntivirusConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The protected object ID.`,
+			},
+			"ips_protection_mode": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: `The IPS protection mode, 0 (observation), 1 (strict interception), 2 (medium interception) ` +
+					`or 3 (loose interception).`,
+				ValidateFunc: validation.IntBetween(0, 3),
+			},
+			"basic_defense_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: `Whether to enable the basic defense of the IPS.`,
+			},
+			"virtual_patches_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: `Whether to enable the virtual patches of the IPS.`,
+			},
+			"antivirus_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: `Whether to enable the antivirus.`,
+			},
+			"antivirus_protocol_configs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: `The antivirus actions of the protocols.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol_type": {
+							Type:     schema.TypeInt,
+							Required: true,
+							Description: `The protocol type, 0 (HTTP), 1 (SMTP), 2 (POP3), 3 (IMAP4), 4 (FTP) or ` +
+								`5 (SMB).`,
+							ValidateFunc: validation.IntBetween(0, 5),
+						},
+						"action": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  `The antivirus action, 0 (log only) or 1 (block).`,
+							ValidateFunc: validation.IntInSlice([]int{0, 1}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func requestIpsAntivirusConfig(client *golangsdk.ServiceClient, method, httpUrl string,
+	bodyParams map[string]interface{}) (interface{}, error) {
+	requestPath := client.Endpoint + httpUrl
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	if bodyParams != nil {
+		requestOpt.JSONBody = bodyParams
+	}
+	resp, err := client.Request(method, requestPath, &requestOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func boolToCfwStatus(enabled bool) int {
+	if enabled {
+		return 1
+	}
+	return 0
+}
+
+func buildIpsAntivirusProtocolConfigs(rawConfigs []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rawConfigs))
+	for _, v := range rawConfigs {
+		protocolConfig := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"protocol_type": protocolConfig["protocol_type"],
+			"action":        protocolConfig["action"],
+		})
+	}
+	return result
+}
+
+// updateIpsAntivirusConfig applies the parameters which need to be changed, the shouldUpdate decides whether the
+// parameter is changed.
+func updateIpsAntivirusConfig(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	shouldUpdate func(key string) bool) error {
+	objectId := d.Get("object_id").(string)
+	if shouldUpdate("ips_protection_mode") {
+		_, err := requestIpsAntivirusConfig(client, "POST", "v1/{project_id}/ips/protect", map[string]interface{}{
+			"object_id": objectId,
+			"mode":      d.Get("ips_protection_mode"),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating IPS protection mode: %s", err)
+		}
+	}
+
+	ipsSwitches := map[string]int{
+		"basic_defense_enabled":   ipsTypeBasicDefense,
+		"virtual_patches_enabled": ipsTypeVirtualPatches,
+	}
+	for key, ipsType := range ipsSwitches {
+		if !shouldUpdate(key) {
+			continue
+		}
+		_, err := requestIpsAntivirusConfig(client, "POST", "v1/{project_id}/ips/switch", map[string]interface{}{
+			"object_id": objectId,
+			"ips_type":  ipsType,
+			"status":    boolToCfwStatus(d.Get(key).(bool)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating IPS switch (%s): %s", key, err)
+		}
+	}
+
+	if shouldUpdate("antivirus_enabled") {
+		_, err := requestIpsAntivirusConfig(client, "POST", "v1/{project_id}/anti-virus/switch",
+			map[string]interface{}{
+				"object_id":         objectId,
+				"anti_virus_status": boolToCfwStatus(d.Get("antivirus_enabled").(bool)),
+			})
+		if err != nil {
+			return fmt.Errorf("error updating antivirus switch: %s", err)
+		}
+	}
+
+	if shouldUpdate("antivirus_protocol_configs") {
+		rawConfigs := d.Get("antivirus_protocol_configs").(*schema.Set).List()
+		_, err := requestIpsAntivirusConfig(client, "PUT", "v1/{project_id}/anti-virus/rule", map[string]interface{}{
+			"object_id":             objectId,
+			"scan_protocol_configs": buildIpsAntivirusProtocolConfigs(rawConfigs),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating antivirus protocol configurations: %s", err)
+		}
+	}
+	return nil
+}
+
+func resourceIpsAntivirusConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	err = updateIpsAntivirusConfig(client, d, func(key string) bool {
+		return isCfwParamConfigured(d, key)
+	})
+	if err != nil {
+		return diag.Errorf("error creating CFW IPS and antivirus configuration: %s", err)
+	}
+	d.SetId(d.Get("object_id").(string))
+
+	return resourceIpsAntivirusConfigRead(ctx, d, meta)
+}
+
+func flattenIpsAntivirusProtocolConfigs(rawConfigs interface{}) []map[string]interface{} {
+	configs, ok := rawConfigs.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(configs))
+	for _, v := range configs {
+		result = append(result, map[string]interface{}{
+			"protocol_type": utils.PathSearch("protocol_type", v, nil),
+			"action":        utils.PathSearch("action", v, nil),
+		})
+	}
+	return result
+}
+
+func resourceIpsAntivirusConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cfw", region)
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	query := fmt.Sprintf("?object_id=%s", d.Id())
+	ipsMode, err := requestIpsAntivirusConfig(client, "GET", "v1/{project_id}/ips/protect"+query, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IPS protection mode")
+	}
+	ipsSwitch, err := requestIpsAntivirusConfig(client, "GET", "v1/{project_id}/ips/switch"+query, nil)
+	if err != nil {
+		return diag.Errorf("error retrieving IPS switches: %s", err)
+	}
+	antivirusSwitch, err := requestIpsAntivirusConfig(client, "GET", "v1/{project_id}/anti-virus/switch"+query, nil)
+	if err != nil {
+		return diag.Errorf("error retrieving antivirus switch: %s", err)
+	}
+	antivirusRule, err := requestIpsAntivirusConfig(client, "GET", "v1/{project_id}/anti-virus/rule"+query, nil)
+	if err != nil {
+		return diag.Errorf("error retrieving antivirus protocol configurations: %s", err)
+	}
+
+	isEnabled := func(expression string, resp interface{}) bool {
+		return utils.PathSearch(expression, resp, float64(0)).(float64) == 1
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("object_id", d.Id()),
+		d.Set("ips_protection_mode", utils.PathSearch("data.mode", ipsMode, nil)),
+		d.Set("basic_defense_enabled", isEnabled("data.basic_defense_status", ipsSwitch)),
+		d.Set("virtual_patches_enabled", isEnabled("data.virtual_patches_status", ipsSwitch)),
+		d.Set("antivirus_enabled", isEnabled("data.anti_virus_status", antivirusSwitch)),
+		d.Set("antivirus_protocol_configs", flattenIpsAntivirusProtocolConfigs(
+			utils.PathSearch("data.scan_protocol_configs", antivirusRule, nil))),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CFW IPS and antivirus configuration fields: %s", err)
+	}
+	return nil
+}
+
+func resourceIpsAntivirusConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cfw", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CFW client: %s", err)
+	}
+
+	if err = updateIpsAntivirusConfig(client, d, d.HasChange); err != nil {
+		return diag.Errorf("error updating CFW IPS and antivirus configuration: %s", err)
+	}
+
+	return resourceIpsAntivirusConfigRead(ctx, d, meta)
+}
+
+func resourceIpsAntivirusConfigDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The IPS and antivirus configurations can not be deleted, they are kept to avoid weakening the protection.
+	return nil
+}
@@ -0,0 +1,123 @@
+package cts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// keyOperations is the catalog of the key operations which are commonly concerned, the structure is
+// service type -> resource type -> trace names.
+// The catalog is not exhaustive, so the operations which are not in the catalog are only reported as warnings by
+// huaweicloud_cts_notification if the strict validation is disabled by validate_operations.
+var keyOperations = map[string]map[string][]string{
+	"CTS": {
+		"notification": {"createNotification", "deleteNotification", "updateNotification"},
+		"tracker":      {"createTracker", "deleteTracker", "updateTracker"},
+	},
+	"ECS": {
+		"ecs": {"changeOs", "createServer", "deleteServer", "deleteServers", "rebootServer", "reinstallOs",
+			"resizeServer", "startServer", "stopServer"},
+	},
+	"EVS": {
+		"evs": {"deleteVolume", "detachVolume", "extendVolume"},
+	},
+	"IAM": {
+		"agency":           {"createAgency", "deleteAgency", "updateAgency"},
+		"credential":       {"createCredential", "deleteCredential", "updateCredential"},
+		"identityProvider": {"createIdentityProvider", "deleteIdentityProvider", "updateIdentityProvider"},
+		"policy":           {"createPolicy", "deletePolicy", "updatePolicy"},
+		"user": {"addUserToGroup", "createUser", "deleteUser", "login", "loginFailed", "removeUserFromGroup",
+			"updateUser", "updateUserPwd"},
+		"userGroup": {"createUserGroup", "deleteUserGroup", "updateUserGroup"},
+	},
+	"KMS": {
+		"cmk": {"cancelKeyDeletion", "deleteImportedKeyMaterial", "disableKey", "importKeyMaterial",
+			"scheduleKeyDeletion"},
+	},
+	"OBS": {
+		"bucket": {"deleteBucket", "deleteBucketPolicy", "setBucketAcl", "setBucketPolicy"},
+	},
+	"RDS": {
+		"instance": {"deleteInstance", "instanceRestart", "setOrResetPassword"},
+	},
+	"VPC": {
+		"eip":               {"deleteEip", "unbindEip"},
+		"securityGroup":     {"deleteSecurityGroup"},
+		"securityGroupRule": {"createSecurityGroupRule", "deleteSecurityGroupRule"},
+		"subnet":            {"deleteSubnet", "modifySubnet"},
+		"vpc":               {"deleteVpc", "modifyVpc"},
+	},
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateKeyOperation checks whether the operation of the service is in the catalog, nil is returned if the service
+// is not in the catalog.
+func validateKeyOperation(service, resourceType string, traceNames []string) error {
+	resources, ok := keyOperations[service]
+	if !ok {
+		return nil
+	}
+
+	validNames, ok := resources[resourceType]
+	if !ok {
+		return fmt.Errorf("the resource type (%s) of the service (%s) is not a key operation, valid values are: %s",
+			resourceType, service, strings.Join(sortedKeys(resources), ", "))
+	}
+	for _, name := range traceNames {
+		if !utils.StrSliceContains(validNames, name) {
+			return fmt.Errorf("the trace name (%s) of the resource type (%s/%s) is not a key operation, "+
+				"valid values are: %s", name, service, resourceType, strings.Join(validNames, ", "))
+		}
+	}
+	return nil
+}
+
+// resourceCTSNotificationCustomizeDiff validates the changed operations against the catalog, an error is returned
+// unless validate_operations is disabled, then the invalid operations are logged as warnings.
+func resourceCTSNotificationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChanges("operations", "validate_operations") || !d.NewValueKnown("operations") {
+		return nil
+	}
+
+	strict := d.Get("validate_operations").(bool)
+	oldRaw, newRaw := d.GetChange("operations")
+	oldOperations, _ := oldRaw.([]interface{})
+	for i, v := range newRaw.([]interface{}) {
+		operation, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// The unchanged operations are skipped, except that the strict validation is enabled just now.
+		if !d.HasChange("validate_operations") && i < len(oldOperations) && reflect.DeepEqual(oldOperations[i], v) {
+			continue
+		}
+
+		rawNames, _ := operation["trace_names"].([]interface{})
+		err := validateKeyOperation(fmt.Sprint(operation["service"]), fmt.Sprint(operation["resource"]),
+			utils.ExpandToStringList(rawNames))
+		if err == nil {
+			continue
+		}
+		if strict {
+			return fmt.Errorf("invalid operations.%d: %s", i, err)
+		}
+		log.Printf("[WARN] operations.%d may be invalid: %s", i, err)
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+package cts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/kms/v1/keys"
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	kmsKeyStateEnabled = "2"
+)
+
+// The trace files can only be encrypted by the symmetric keys.
+var ctsSupportedKeySpecs = []string{"AES_256", "SM4"}
+
+// obsPolicyStatement is the statement of the OBS bucket policy, the fields can be a string or a list of strings.
+type obsPolicyStatement struct {
+	Effect    string      `json:"Effect"`
+	Principal interface{} `json:"Principal"`
+	Action    interface{} `json:"Action"`
+	Resource  interface{} `json:"Resource"`
+	Condition interface{} `json:"Condition"`
+}
+
+type obsBucketPolicy struct {
+	Statement []obsPolicyStatement `json:"Statement"`
+}
+
+func toStringList(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		result := make([]string, 0, len(val))
+		for _, item := range val {
+			result = append(result, fmt.Sprint(item))
+		}
+		return result
+	case map[string]interface{}:
+		result := make([]string, 0)
+		for _, item := range val {
+			result = append(result, toStringList(item)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// matchObsPattern checks whether the value matches the pattern of the bucket policy, the asterisk (*) and the
+// question mark (?) are supported.
+func matchObsPattern(pattern, value string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expr+"$", value)
+	return err == nil && matched
+}
+
+func matchAnyObsPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchObsPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCTSPrincipal checks whether the principals of the bucket policy include CTS, that is, all principals, the account
+// which the tracker belongs to, or the CTS service.
+func isCTSPrincipal(principals []string, domainId string) bool {
+	for _, principal := range principals {
+		if principal == "*" || strings.Contains(strings.ToLower(principal), "cts") ||
+			(domainId != "" && strings.HasPrefix(principal, "domain/"+domainId)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkBucketPolicyDenyCTS checks whether the bucket policy denies the writing of the trace files for all principals.
+// The conditional statements are ignored because they can not be evaluated before the writing.
+func checkBucketPolicyDenyCTS(bucketPolicy *obsBucketPolicy, objectPath string) error {
+	for _, statement := range bucketPolicy.Statement {
+		if !strings.EqualFold(statement.Effect, "Deny") || statement.Condition != nil {
+			continue
+		}
+		if !matchAnyObsPattern(toStringList(statement.Principal), "*") {
+			continue
+		}
+		if matchAnyObsPattern(toStringList(statement.Action), "PutObject") &&
+			matchAnyObsPattern(toStringList(statement.Resource), objectPath) {
+			return fmt.Errorf("the bucket policy denies the PutObject action on %s for all principals", objectPath)
+		}
+	}
+	return nil
+}
+
+// checkBucketPolicyAllowCTS checks whether the bucket policy grants CTS the permission to write the trace files.
+func checkBucketPolicyAllowCTS(bucketPolicy *obsBucketPolicy, objectPath, domainId string) error {
+	for _, statement := range bucketPolicy.Statement {
+		if !strings.EqualFold(statement.Effect, "Allow") || !isCTSPrincipal(toStringList(statement.Principal), domainId) {
+			continue
+		}
+		if matchAnyObsPattern(toStringList(statement.Action), "PutObject") &&
+			matchAnyObsPattern(toStringList(statement.Resource), objectPath) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the bucket policy does not allow CTS to perform the PutObject action on %s", objectPath)
+}
+
+// isObsAccessDenied checks whether the OBS request is rejected because of the missing permissions.
+func isObsAccessDenied(err error) bool {
+	obsError, ok := err.(obs.ObsError)
+	return ok && obsError.StatusCode == 403
+}
+
+// validateTrackerBucket checks whether the bucket exists and the bucket policy allows CTS to write the trace files.
+// The validation is skipped with a warning if the bucket policy or ACL can not be read.
+func validateTrackerBucket(cfg *config.Config, region, bucket, filePrefix string) error {
+	obsClient, err := cfg.ObjectStorageClient(region)
+	if err != nil {
+		return fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	if _, err = obsClient.HeadBucket(bucket); err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+			return fmt.Errorf("the OBS bucket (%s) does not exist in the region (%s)", bucket, region)
+		}
+		if isObsAccessDenied(err) {
+			log.Printf("[WARN] unable to check the OBS bucket (%s), skip the validation: %s", bucket, err)
+			return nil
+		}
+		return fmt.Errorf("error checking the OBS bucket (%s): %s", bucket, err)
+	}
+
+	var bucketPolicy obsBucketPolicy
+	output, err := obsClient.GetBucketPolicy(bucket)
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); !ok || obsError.Code != "NoSuchBucketPolicy" {
+			log.Printf("[WARN] unable to get the policy of the OBS bucket (%s), skip the validation: %s", bucket, err)
+			return nil
+		}
+	} else if err = json.Unmarshal([]byte(output.Policy), &bucketPolicy); err != nil {
+		log.Printf("[WARN] unable to parse the policy of the OBS bucket (%s), skip the validation: %s", bucket, err)
+		return nil
+	}
+
+	// The trace files are written to {bucket}/{file_prefix}/CloudTraces/{region}/...
+	objectPath := bucket + "/CloudTraces/" + region + "/trace"
+	if filePrefix != "" {
+		objectPath = bucket + "/" + filePrefix + "/CloudTraces/" + region + "/trace"
+	}
+	if err = checkBucketPolicyDenyCTS(&bucketPolicy, objectPath); err != nil {
+		return fmt.Errorf("CTS can not write the trace files to the OBS bucket (%s): %s", bucket, err)
+	}
+
+	// The bucket owner always has the permission to write the objects, so the bucket policy is checked only if the
+	// bucket belongs to another account.
+	acl, err := obsClient.GetBucketAcl(bucket)
+	if err != nil {
+		log.Printf("[WARN] unable to get the owner of the OBS bucket (%s), skip the validation: %s", bucket, err)
+		return nil
+	}
+	if cfg.DomainID == "" || acl.Owner.ID == cfg.DomainID {
+		return nil
+	}
+	if err = checkBucketPolicyAllowCTS(&bucketPolicy, objectPath, cfg.DomainID); err != nil {
+		return fmt.Errorf("CTS can not write the trace files to the OBS bucket (%s) of another account: %s",
+			bucket, err)
+	}
+	return nil
+}
+
+// checkKmsKeyGrantCTS checks whether the key of another account is granted to the account of the tracker, which is
+// required by CTS to generate the data keys.
+func checkKmsKeyGrantCTS(client *golangsdk.ServiceClient, keyId, domainId string) error {
+	listPath := client.ServiceURL(client.ProjectID, "kms", "list-grants")
+	params := map[string]interface{}{
+		"key_id": keyId,
+		"limit":  "100",
+	}
+	for {
+		listOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         params,
+		}
+		resp, err := client.Request("POST", listPath, &listOpt)
+		if err != nil {
+			return err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return err
+		}
+
+		expression := fmt.Sprintf("grants[?grantee_principal=='%s'].operations[]", domainId)
+		operations := utils.ExpandToStringList(utils.PathSearch(expression, respBody, make([]interface{}, 0)).([]interface{}))
+		if utils.StrSliceContains(operations, "create-datakey") {
+			return nil
+		}
+		marker := utils.PathSearch("next_marker", respBody, "").(string)
+		// The truncated flag is returned as a string.
+		if fmt.Sprint(utils.PathSearch("truncated", respBody, false)) != "true" || marker == "" {
+			return fmt.Errorf("the KMS key (%s) of another account is not granted to the account (%s) with "+
+				"the create-datakey operation", keyId, domainId)
+		}
+		params["marker"] = marker
+	}
+}
+
+// validateTrackerKmsKey checks whether the KMS key can be used by CTS to encrypt the trace files.
+func validateTrackerKmsKey(cfg *config.Config, region, keyId string) error {
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating KMS client: %s", err)
+	}
+
+	key, err := keys.Get(client, keyId).ExtractKeyInfo()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault403); ok {
+			log.Printf("[WARN] unable to get the KMS key (%s), skip the validation: %s", keyId, err)
+			return nil
+		}
+		return fmt.Errorf("error retrieving the KMS key (%s): %s", keyId, err)
+	}
+	if key.KeyState != kmsKeyStateEnabled {
+		return fmt.Errorf("the KMS key (%s) is not enabled, the current state is %s", keyId, key.KeyState)
+	}
+	if key.KeySpec != "" && !utils.StrSliceContains(ctsSupportedKeySpecs, key.KeySpec) {
+		return fmt.Errorf("the KMS key (%s) can not be used to encrypt the trace files, the key spec must be one of %s",
+			keyId, strings.Join(ctsSupportedKeySpecs, ", "))
+	}
+
+	// The keys of the account are used by CTS through the agency, only the keys of the other accounts need the grants.
+	if cfg.DomainID == "" || key.DomainID == "" || key.DomainID == cfg.DomainID {
+		return nil
+	}
+	if err = checkKmsKeyGrantCTS(client, keyId, cfg.DomainID); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault403); ok {
+			log.Printf("[WARN] unable to list the grants of the KMS key (%s), skip the validation: %s", keyId, err)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func getDiffRegion(d *schema.ResourceDiff, cfg *config.Config) string {
+	if region := d.Get("region").(string); region != "" {
+		return region
+	}
+	return cfg.Region
+}
+
+// validateTrackerBucketDiff validates the OBS bucket of the tracker at plan time, the validation is skipped if the
+// values are unknown (e.g. the bucket is created in the same apply) or not changed.
+func validateTrackerBucketDiff(d *schema.ResourceDiff, cfg *config.Config) error {
+	if !d.HasChanges("bucket_name", "file_prefix") || !d.NewValueKnown("bucket_name") ||
+		!d.NewValueKnown("file_prefix") {
+		return nil
+	}
+	bucket := d.Get("bucket_name").(string)
+	if bucket == "" {
+		return nil
+	}
+	return validateTrackerBucket(cfg, getDiffRegion(d, cfg), bucket, d.Get("file_prefix").(string))
+}
+
+func resourceCTSTrackerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := meta.(*config.Config)
+	if err := validateTrackerBucketDiff(d, cfg); err != nil {
+		return err
+	}
+
+	if !d.HasChange("kms_id") || !d.NewValueKnown("kms_id") {
+		return nil
+	}
+	keyId := d.Get("kms_id").(string)
+	if keyId == "" {
+		return nil
+	}
+	return validateTrackerKmsKey(cfg, getDiffRegion(d, cfg), keyId)
+}
+
+func resourceCTSDataTrackerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateTrackerBucketDiff(d, meta.(*config.Config))
+}
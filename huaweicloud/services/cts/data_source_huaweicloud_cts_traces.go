@@ -0,0 +1,265 @@
+package cts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	cts "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cts/v3/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceCTSTraces is the impl of huaweicloud_cts_traces, which queries the traces recorded by CTS.
+func DataSourceCTSTraces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCTSTracesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"trace_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "system",
+				ValidateFunc: validation.StringInSlice([]string{"system", "data"}, false),
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tracker_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_rating": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"normal", "warning", "incident"}, false),
+			},
+
+			"traces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rating": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseTraceTime(d *schema.ResourceData, key string) *int64 {
+	v, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+	// The value has been validated by the schema.
+	t, _ := time.Parse(time.RFC3339, v.(string))
+	timestamp := t.UnixNano() / int64(time.Millisecond)
+	return &timestamp
+}
+
+func buildListTracesRequest(d *schema.ResourceData) *cts.ListTracesRequest {
+	traceType := cts.GetListTracesRequestTraceTypeEnum().SYSTEM
+	if d.Get("trace_type").(string) == "data" {
+		traceType = cts.GetListTracesRequestTraceTypeEnum().DATA
+	}
+
+	request := cts.ListTracesRequest{
+		TraceType:    traceType,
+		Limit:        utils.Int32(200),
+		From:         parseTraceTime(d, "from"),
+		To:           parseTraceTime(d, "to"),
+		TrackerName:  utils.StringIgnoreEmpty(d.Get("tracker_name").(string)),
+		ServiceType:  utils.StringIgnoreEmpty(d.Get("service_type").(string)),
+		User:         utils.StringIgnoreEmpty(d.Get("user").(string)),
+		ResourceId:   utils.StringIgnoreEmpty(d.Get("resource_id").(string)),
+		ResourceName: utils.StringIgnoreEmpty(d.Get("resource_name").(string)),
+		ResourceType: utils.StringIgnoreEmpty(d.Get("resource_type").(string)),
+		TraceName:    utils.StringIgnoreEmpty(d.Get("trace_name").(string)),
+	}
+	if v, ok := d.GetOk("trace_rating"); ok {
+		rating := cts.ListTracesRequestTraceRating{}
+		// The value has been validated by the schema.
+		_ = rating.UnmarshalJSON([]byte(v.(string)))
+		request.TraceRating = &rating
+	}
+	return &request
+}
+
+func flattenTraces(traces []cts.Traces) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(traces))
+	for i, v := range traces {
+		var userName, rating, traceTime string
+		if v.User != nil {
+			userName = utils.StringValue(v.User.Name)
+		}
+		if v.TraceRating != nil {
+			rating = formatValue(v.TraceRating)
+		}
+		if v.Time != nil {
+			traceTime = utils.FormatTimeStampRFC3339(*v.Time/1000, false)
+		}
+		result[i] = map[string]interface{}{
+			"id":            v.TraceId,
+			"name":          v.TraceName,
+			"type":          v.TraceType,
+			"rating":        rating,
+			"service_type":  v.ServiceType,
+			"resource_type": v.ResourceType,
+			"resource_id":   v.ResourceId,
+			"resource_name": v.ResourceName,
+			"user":          userName,
+			"source_ip":     v.SourceIp,
+			"code":          v.Code,
+			"message":       v.Message,
+			"request_id":    v.RequestId,
+			"api_version":   v.ApiVersion,
+			"time":          traceTime,
+		}
+	}
+	return result
+}
+
+func dataSourceCTSTracesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	ctsClient, err := cfg.HcCtsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CTS client: %s", err)
+	}
+
+	request := buildListTracesRequest(d)
+	allTraces := make([]cts.Traces, 0)
+	for {
+		response, err := ctsClient.ListTraces(request)
+		if err != nil {
+			return diag.Errorf("error retrieving CTS traces: %s", err)
+		}
+		if response.Traces == nil || len(*response.Traces) == 0 {
+			break
+		}
+		allTraces = append(allTraces, *response.Traces...)
+
+		// The marker is the ID of the last trace, it is empty if all traces have been returned.
+		if response.MetaData == nil || utils.StringValue(response.MetaData.Marker) == "" {
+			break
+		}
+		request.Next = response.MetaData.Marker
+	}
+
+	ids := make([]string, len(allTraces))
+	for i, v := range allTraces {
+		ids[i] = utils.StringValue(v.TraceId)
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("traces", flattenTraces(allTraces)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CTS traces fields: %s", err)
+	}
+	return nil
+}
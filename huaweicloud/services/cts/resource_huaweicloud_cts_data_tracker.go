@@ -25,6 +25,7 @@ func ResourceCTSDataTracker() *schema.Resource {
 		ReadContext:   resourceCTSDataTrackerRead,
 		UpdateContext: resourceCTSDataTrackerUpdate,
 		DeleteContext: resourceCTSDataTrackerDelete,
+		CustomizeDiff: resourceCTSDataTrackerCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
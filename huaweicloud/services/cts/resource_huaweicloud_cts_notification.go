@@ -25,6 +25,7 @@ func ResourceCTSNotification() *schema.Resource {
 		ReadContext:   resourceCTSNotificationRead,
 		UpdateContext: resourceCTSNotificationUpdate,
 		DeleteContext: resourceCTSNotificationDelete,
+		CustomizeDiff: resourceCTSNotificationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -107,6 +108,11 @@ func ResourceCTSNotification() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"validate_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 
 			"notification_id": {
 				Type:     schema.TypeString,
@@ -24,6 +24,7 @@ func ResourceCTSTracker() *schema.Resource {
 		ReadContext:   resourceCTSTrackerRead,
 		UpdateContext: resourceCTSTrackerUpdate,
 		DeleteContext: resourceCTSTrackerDelete,
+		CustomizeDiff: resourceCTSTrackerCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCTSTrackerImportState,
 		},
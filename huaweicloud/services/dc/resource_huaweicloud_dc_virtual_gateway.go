@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/dc/v3/gateways"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
@@ -35,10 +36,19 @@ func ResourceVirtualGateway() *schema.Resource {
 				Description: "The region where the virtual gateway is located.",
 			},
 			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"vpc_id", "enterprise_router_id"},
+				Description:  "The ID of the VPC connected to the virtual gateway.",
+			},
+			"enterprise_router_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "The ID of the VPC connected to the virtual gateway.",
+				Description: "The ID of the ER instance connected to the virtual gateway.",
 			},
 			"local_ep_group": {
 				Type:     schema.TypeList,
@@ -92,14 +102,17 @@ func ResourceVirtualGateway() *schema.Resource {
 	}
 }
 
-func buildVirtualGatewayCreateOpts(d *schema.ResourceData, cfg *config.Config) gateways.CreateOpts {
-	return gateways.CreateOpts{
-		VpcId:               d.Get("vpc_id").(string),
-		LocalEpGroup:        utils.ExpandToStringList(d.Get("local_ep_group").([]interface{})),
-		Name:                d.Get("name").(string),
-		Description:         d.Get("description").(string),
-		BgpAsn:              d.Get("asn").(int),
-		EnterpriseProjectId: common.GetEnterpriseProjectID(d, cfg),
+func buildVirtualGatewayCreateBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	return map[string]interface{}{
+		"virtual_gateway": utils.RemoveNil(map[string]interface{}{
+			"vpc_id":                utils.ValueIngoreEmpty(d.Get("vpc_id")),
+			"enterprise_router_id":  utils.ValueIngoreEmpty(d.Get("enterprise_router_id")),
+			"local_ep_group":        utils.ExpandToStringList(d.Get("local_ep_group").([]interface{})),
+			"name":                  d.Get("name"),
+			"description":           utils.ValueIngoreEmpty(d.Get("description")),
+			"bgp_asn":               utils.ValueIngoreEmpty(d.Get("asn")),
+			"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+		}),
 	}
 }
 
@@ -110,12 +123,26 @@ func resourceVirtualGatewayCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("error creating DC v3 client: %s", err)
 	}
 
-	opts := buildVirtualGatewayCreateOpts(d, cfg)
-	resp, err := gateways.Create(client, opts)
+	// The gateway SDK does not support the ER instance yet, so the virtual gateway is created by the raw request.
+	createPath := client.ServiceURL("dcaas", "virtual-gateways")
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildVirtualGatewayCreateBodyParams(d, cfg),
+		MoreHeaders:      map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
 	if err != nil {
 		return diag.Errorf("error creating virtual gateway: %s", err)
 	}
-	d.SetId(resp.ID)
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayId := utils.PathSearch("virtual_gateway.id", respBody, "").(string)
+	if gatewayId == "" {
+		return diag.Errorf("unable to find the virtual gateway ID from the API response")
+	}
+	d.SetId(gatewayId)
 
 	return resourceVirtualGatewayRead(ctx, d, meta)
 }
@@ -128,20 +155,30 @@ func resourceVirtualGatewayRead(_ context.Context, d *schema.ResourceData, meta
 	}
 
 	gatewayId := d.Id()
-	resp, err := gateways.Get(client, gatewayId)
+	getPath := client.ServiceURL("dcaas", "virtual-gateways", gatewayId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "virtual gateway")
 	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	mErr := multierror.Append(nil,
 		d.Set("region", cfg.GetRegion(d)),
-		d.Set("vpc_id", resp.VpcId),
-		d.Set("local_ep_group", resp.LocalEpGroup),
-		d.Set("name", resp.Name),
-		d.Set("description", resp.Description),
-		d.Set("asn", resp.BgpAsn),
-		d.Set("enterprise_project_id", resp.EnterpriseProjectId),
-		d.Set("status", resp.Status),
+		d.Set("vpc_id", utils.PathSearch("virtual_gateway.vpc_id", respBody, nil)),
+		d.Set("enterprise_router_id", utils.PathSearch("virtual_gateway.enterprise_router_id", respBody, nil)),
+		d.Set("local_ep_group", utils.PathSearch("virtual_gateway.local_ep_group", respBody, nil)),
+		d.Set("name", utils.PathSearch("virtual_gateway.name", respBody, nil)),
+		d.Set("description", utils.PathSearch("virtual_gateway.description", respBody, nil)),
+		d.Set("asn", utils.PathSearch("virtual_gateway.bgp_asn", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("virtual_gateway.enterprise_project_id", respBody, nil)),
+		d.Set("status", utils.PathSearch("virtual_gateway.status", respBody, nil)),
 	)
 
 	if err = mErr.ErrorOrNil(); err != nil {
@@ -0,0 +1,175 @@
+package dcs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceDcsKeyAnalysisResult is the impl for huaweicloud_dcs_key_analysis_result data source, which returns the
+// keys found by a hot key or big key analysis task. The latest successful task is used if the task ID is omitted.
+func DataSourceDcsKeyAnalysisResult() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDcsKeyAnalysisResultRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					dcsKeyAnalysisTypeHotKey, dcsKeyAnalysisTypeBigKey,
+				}, false),
+			},
+			"task_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shard": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"freq": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getLatestDcsKeyAnalysisId(client *golangsdk.ServiceClient, analysisType, instanceId string) (string, error) {
+	listPath := client.Endpoint + "v2/{project_id}/instances/{instance_id}/{type}-tasks?status=success&offset=0&limit=1"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{instance_id}", instanceId)
+	listPath = strings.ReplaceAll(listPath, "{type}", analysisType)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("records[0].id", respBody, "").(string), nil
+}
+
+func flattenDcsKeyAnalysisKeys(task interface{}) []map[string]interface{} {
+	keys := utils.PathSearch("keys", task, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, map[string]interface{}{
+			"name":  utils.PathSearch("name", key, nil),
+			"type":  utils.PathSearch("type", key, nil),
+			"shard": utils.PathSearch("shard", key, nil),
+			"db":    utils.PathSearch("db", key, nil),
+			"size":  utils.PathSearch("size", key, nil),
+			"unit":  utils.PathSearch("unit", key, nil),
+			"freq":  utils.PathSearch("freq", key, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceDcsKeyAnalysisResultRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	analysisType := d.Get("type").(string)
+	taskId := d.Get("task_id").(string)
+	if taskId == "" {
+		if taskId, err = getLatestDcsKeyAnalysisId(client, analysisType, instanceId); err != nil {
+			return diag.Errorf("error querying DCS %s analyses: %s", analysisType, err)
+		}
+		if taskId == "" {
+			return diag.Errorf("no successful %s analysis found for DCS instance (%s)", analysisType, instanceId)
+		}
+	}
+
+	task, err := getDcsKeyAnalysis(client, analysisType, instanceId, taskId)
+	if err != nil {
+		return diag.Errorf("error retrieving DCS %s analysis (%s): %s", analysisType, taskId, err)
+	}
+	d.SetId(taskId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("task_id", taskId),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+		d.Set("scan_type", utils.PathSearch("scan_type", task, nil)),
+		d.Set("started_at", utils.PathSearch("started_at", task, nil)),
+		d.Set("finished_at", utils.PathSearch("finished_at", task, nil)),
+		d.Set("keys", flattenDcsKeyAnalysisKeys(task)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
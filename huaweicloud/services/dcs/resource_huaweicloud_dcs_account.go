@@ -0,0 +1,294 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDcsAccount is the impl for huaweicloud_dcs_account resource, which manages the ACL account of the Redis 4.0
+// and later instances.
+func ResourceDcsAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsAccountCreate,
+		ReadContext:   resourceDcsAccountRead,
+		UpdateContext: resourceDcsAccountUpdate,
+		DeleteContext: resourceDcsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsAccountImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"account_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"read", "write"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDcsAccountPath(client *golangsdk.ServiceClient, instanceId, accountId string) string {
+	path := client.Endpoint + "v2/{project_id}/instances/{instance_id}/accounts"
+	if accountId != "" {
+		path += "/" + accountId
+	}
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+func resourceDcsAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"account_name":     d.Get("account_name"),
+			"account_password": d.Get("account_password"),
+			"account_role":     d.Get("account_role"),
+			"description":      utils.ValueIngoreEmpty(d.Get("description")),
+		}),
+	}
+	resp, err := client.Request("POST", buildDcsAccountPath(client, instanceId, ""), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating DCS account: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountId := utils.PathSearch("account_id", respBody, "").(string)
+	if accountId == "" {
+		return diag.Errorf("error creating DCS account: ID is not found in API response")
+	}
+	d.SetId(accountId)
+
+	if err = waitForDcsAccountAvailable(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for DCS account (%s) to be available: %s", accountId, err)
+	}
+	return resourceDcsAccountRead(ctx, d, meta)
+}
+
+// getDcsAccount returns the account from the account list of the instance, the API does not support to query an
+// account by the ID.
+func getDcsAccount(client *golangsdk.ServiceClient, instanceId, accountId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", buildDcsAccountPath(client, instanceId, ""), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	account := utils.PathSearch(fmt.Sprintf("accounts[?account_id=='%s']|[0]", accountId), respBody, nil)
+	if account == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return account, nil
+}
+
+func waitForDcsAccountAvailable(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	instanceId := d.Get("instance_id").(string)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING", "UPDATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			account, err := getDcsAccount(client, instanceId, d.Id())
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", account, "").(string)
+			if status == "ERROR" {
+				return account, status, fmt.Errorf("the status of the account is ERROR")
+			}
+			return account, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDcsAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	account, err := getDcsAccount(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS account")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("account_name", utils.PathSearch("account_name", account, nil)),
+		d.Set("account_role", utils.PathSearch("account_role", account, nil)),
+		d.Set("description", utils.PathSearch("description", account, nil)),
+		d.Set("account_type", utils.PathSearch("account_type", account, nil)),
+		d.Set("status", utils.PathSearch("status", account, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDcsAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	accountPath := buildDcsAccountPath(client, instanceId, d.Id())
+	if d.HasChanges("account_role", "description") {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+			JSONBody: map[string]interface{}{
+				"account_role": d.Get("account_role"),
+				"description":  d.Get("description"),
+			},
+		}
+		if _, err = client.Request("PUT", accountPath, &updateOpt); err != nil {
+			return diag.Errorf("error updating DCS account (%s): %s", d.Id(), err)
+		}
+		if err = waitForDcsAccountAvailable(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for DCS account (%s) to be available: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("account_password") {
+		resetOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+			JSONBody: map[string]interface{}{
+				"new_password": d.Get("account_password"),
+			},
+		}
+		if _, err = client.Request("PUT", accountPath+"/password/reset", &resetOpt); err != nil {
+			return diag.Errorf("error resetting password of DCS account (%s): %s", d.Id(), err)
+		}
+		if err = waitForDcsAccountAvailable(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for DCS account (%s) to be available: %s", d.Id(), err)
+		}
+	}
+	return resourceDcsAccountRead(ctx, d, meta)
+}
+
+func resourceDcsAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	if _, err = client.Request("DELETE", buildDcsAccountPath(client, instanceId, d.Id()), &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DCS account")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			account, err := getDcsAccount(client, instanceId, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return account, "DELETING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DCS account (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceDcsAccountImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<account_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
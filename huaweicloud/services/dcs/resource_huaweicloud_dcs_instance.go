@@ -50,6 +50,7 @@ func ResourceDcsInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceDcsInstanceCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -80,10 +81,10 @@ func ResourceDcsInstance() *schema.Resource {
 					"Redis", "Memcached",
 				}, true),
 			},
+			// The engine version can be upgraded in place, see resourceDcsInstanceCustomizeDiff.
 			"engine_version": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"capacity": {
 				Type:     schema.TypeFloat,
@@ -416,6 +417,66 @@ func resourceDcsInstancesCheck(d *schema.ResourceData) error {
 	return nil
 }
 
+// dcsInstanceTypeChanges is the instance type changes supported by the resize API, the type is parsed from the flavor,
+// e.g. the type of redis.ha.xu1.large.r2.2 is ha.
+var dcsInstanceTypeChanges = map[string][]string{
+	"single": {"ha"},
+	"ha":     {"proxy"},
+	"proxy":  {"ha"},
+}
+
+func getDcsInstanceType(specCode string) string {
+	parts := strings.Split(specCode, ".")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// isDcsEngineVersionUpgradable checks whether the Redis engine can be upgraded from the old version to the new version.
+// Redis 3.0 uses a different architecture and can not be upgraded, and the version can not be downgraded.
+func isDcsEngineVersionUpgradable(engine, oldVersion, newVersion string) bool {
+	if !strings.EqualFold(engine, "Redis") || oldVersion == "" || newVersion == "" {
+		return false
+	}
+	oldValue, err := strconv.ParseFloat(oldVersion, floatBitSize)
+	if err != nil {
+		return false
+	}
+	newValue, err := strconv.ParseFloat(newVersion, floatBitSize)
+	if err != nil {
+		return false
+	}
+	return oldValue >= 4 && newValue > oldValue
+}
+
+// resourceDcsInstanceCustomizeDiff rebuilds the instance if the engine version or the instance type can not be changed
+// in place.
+func resourceDcsInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("engine_version") {
+		oldVersion, newVersion := d.GetChange("engine_version")
+		if !isDcsEngineVersionUpgradable(d.Get("engine").(string), oldVersion.(string), newVersion.(string)) {
+			if err := d.ForceNew("engine_version"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("flavor") {
+		oldFlavor, newFlavor := d.GetChange("flavor")
+		oldType, newType := getDcsInstanceType(oldFlavor.(string)), getDcsInstanceType(newFlavor.(string))
+		if oldType != "" && newType != "" && oldType != newType &&
+			!utils.StrSliceContains(dcsInstanceTypeChanges[oldType], newType) {
+			return d.ForceNew("flavor")
+		}
+	}
+	return nil
+}
+
 func buildBssParamParams(d *schema.ResourceData) instances.DcsBssParam {
 	bp := instances.DcsBssParam{
 		ChargingMode: d.Get("charging_mode").(string),
@@ -801,6 +862,13 @@ func resourceDcsInstancesUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	// The engine version is upgraded before the resize, the new instance type may require the new engine version.
+	if d.HasChange("engine_version") {
+		if err = upgradeDcsInstanceEngineVersion(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// resize instance
 	err = resizeDcsInstance(ctx, d, meta)
 	if err != nil {
@@ -913,6 +981,16 @@ func resizeDcsInstance(ctx context.Context, d *schema.ResourceData, meta interfa
 				IsAutoPay: common.GetAutoPay(d),
 			}
 		}
+		// The change type is required if the instance type is changed, and the standby node of the master/standby
+		// instance changed from the single-node instance is created in the AZs of the instance.
+		oldFlavor, _ := d.GetChange("flavor")
+		oldType, newType := getDcsInstanceType(oldFlavor.(string)), getDcsInstanceType(specCode)
+		if oldType != newType {
+			opts.ChangeType = "instanceType"
+			if oldType == "single" {
+				opts.AvailableZones = utils.ExpandToStringList(d.Get("availability_zones").([]interface{}))
+			}
+		}
 		logp.Printf("[DEBUG] Resize DCS dcs instance options : %#v", opts)
 
 		r, err := instances.ResizeInstance(client, d.Id(), opts)
@@ -932,9 +1010,9 @@ func resizeDcsInstance(ctx context.Context, d *schema.ResourceData, meta interfa
 			}
 		}
 
-		// wait for dcs instance change
+		// wait for dcs instance change, the instance is in the RESTARTING status during the instance type change.
 		err = waitForDcsInstanceCompleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate),
-			[]string{"EXTENDING"}, []string{"RUNNING"})
+			[]string{"EXTENDING", "RESTARTING"}, []string{"RUNNING"})
 		if err != nil {
 			return err
 		}
@@ -952,6 +1030,41 @@ func resizeDcsInstance(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func upgradeDcsInstanceEngineVersion(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	targetVersion := d.Get("engine_version").(string)
+	upgradePath := client.Endpoint + "v2/{project_id}/instances/{instance_id}/engine-upgrade"
+	upgradePath = strings.ReplaceAll(upgradePath, "{project_id}", client.ProjectID)
+	upgradePath = strings.ReplaceAll(upgradePath, "{instance_id}", d.Id())
+	upgradeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+		JSONBody: map[string]interface{}{
+			"target_version": targetVersion,
+		},
+	}
+	logp.Printf("[DEBUG] Upgrade engine version of DCS instance (%s) to %s", d.Id(), targetVersion)
+	if _, err := client.Request("POST", upgradePath, &upgradeOpt); err != nil {
+		return fmt.Errorf("error upgrading engine version of DCS instance (%s): %s", d.Id(), err)
+	}
+
+	err := waitForDcsInstanceCompleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate),
+		[]string{"UPGRADING"}, []string{"RUNNING"})
+	if err != nil {
+		return err
+	}
+
+	// check the result of the upgrade
+	instance, err := instances.Get(client, d.Id())
+	if err != nil {
+		return common.CheckDeleted(d, err, "DCS instance")
+	}
+	if instance.EngineVersion != targetVersion {
+		return fmt.Errorf("error upgrading engine version of DCS instance (%s), the engine version is still %s, "+
+			"expected: %s", d.Id(), instance.EngineVersion, targetVersion)
+	}
+	return nil
+}
+
 func resourceDcsInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conf := meta.(*config.Config)
 	client, err := conf.DcsV2Client(conf.GetRegion(d))
@@ -0,0 +1,226 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The hot key and big key analyses share the same APIs except the path, e.g. the hot key analysis task is created by
+// POST /v2/{project_id}/instances/{instance_id}/hotkey-task.
+const (
+	dcsKeyAnalysisTypeHotKey = "hotkey"
+	dcsKeyAnalysisTypeBigKey = "bigkey"
+)
+
+// ResourceDcsHotKeyAnalysis is the impl for huaweicloud_dcs_hotkey_analysis resource.
+func ResourceDcsHotKeyAnalysis() *schema.Resource {
+	return resourceDcsKeyAnalysis(dcsKeyAnalysisTypeHotKey)
+}
+
+// ResourceDcsBigKeyAnalysis is the impl for huaweicloud_dcs_bigkey_analysis resource.
+func ResourceDcsBigKeyAnalysis() *schema.Resource {
+	return resourceDcsKeyAnalysis(dcsKeyAnalysisTypeBigKey)
+}
+
+// resourceDcsKeyAnalysis returns the resource which creates an analysis task of the instance and waits for the task to
+// be finished, the analysis results are exposed by the huaweicloud_dcs_key_analysis_result data source.
+func resourceDcsKeyAnalysis(analysisType string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceDcsKeyAnalysisCreate(ctx, d, meta, analysisType)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceDcsKeyAnalysisRead(ctx, d, meta, analysisType)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceDcsKeyAnalysisDelete(ctx, d, meta, analysisType)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsKeyAnalysisImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDcsKeyAnalysisPath(client *golangsdk.ServiceClient, analysisType, instanceId, taskId string) string {
+	path := client.Endpoint + "v2/{project_id}/instances/{instance_id}/{type}-task"
+	if taskId != "" {
+		path += "/" + taskId
+	}
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceId)
+	return strings.ReplaceAll(path, "{type}", analysisType)
+}
+
+func resourceDcsKeyAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{},
+	analysisType string) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+	}
+	resp, err := client.Request("POST", buildDcsKeyAnalysisPath(client, analysisType, instanceId, ""), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating DCS %s analysis: %s", analysisType, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	taskId := utils.PathSearch("id", respBody, "").(string)
+	if taskId == "" {
+		return diag.Errorf("error creating DCS %s analysis: ID is not found in API response", analysisType)
+	}
+	d.SetId(taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"waiting", "running"},
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			task, err := getDcsKeyAnalysis(client, analysisType, instanceId, taskId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", task, "").(string)
+			if status == "failed" {
+				return task, status, fmt.Errorf("the analysis task is failed")
+			}
+			return task, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DCS %s analysis (%s) to be finished: %s", analysisType, taskId, err)
+	}
+	return resourceDcsKeyAnalysisRead(ctx, d, meta, analysisType)
+}
+
+func getDcsKeyAnalysis(client *golangsdk.ServiceClient, analysisType, instanceId, taskId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", buildDcsKeyAnalysisPath(client, analysisType, instanceId, taskId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func resourceDcsKeyAnalysisRead(_ context.Context, d *schema.ResourceData, meta interface{},
+	analysisType string) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	task, err := getDcsKeyAnalysis(client, analysisType, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, fmt.Sprintf("error retrieving DCS %s analysis", analysisType))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+		d.Set("scan_type", utils.PathSearch("scan_type", task, nil)),
+		d.Set("num", utils.PathSearch("num", task, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", task, nil)),
+		d.Set("started_at", utils.PathSearch("started_at", task, nil)),
+		d.Set("finished_at", utils.PathSearch("finished_at", task, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDcsKeyAnalysisDelete(_ context.Context, d *schema.ResourceData, meta interface{},
+	analysisType string) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	deletePath := buildDcsKeyAnalysisPath(client, analysisType, d.Get("instance_id").(string), d.Id())
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, fmt.Sprintf("error deleting DCS %s analysis", analysisType))
+	}
+	return nil
+}
+
+func resourceDcsKeyAnalysisImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<task_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
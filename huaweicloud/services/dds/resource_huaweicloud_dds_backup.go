@@ -0,0 +1,243 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdsBackup is the impl for huaweicloud_dds_backup resource, which manages a manual backup of the instance.
+func ResourceDdsBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsBackupCreate,
+		ReadContext:   resourceDdsBackupRead,
+		DeleteContext: resourceDdsBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDdsBackupImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"begin_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"backup": utils.RemoveNil(map[string]interface{}{
+				"instance_id": instanceId,
+				"name":        d.Get("name"),
+				"description": utils.ValueIngoreEmpty(d.Get("description")),
+			}),
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL("backups"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating backup of the DDS instance (%s): %s", instanceId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backupId := utils.PathSearch("backup_id", respBody, "").(string)
+	if backupId == "" {
+		return diag.Errorf("error creating backup of the DDS instance (%s): ID is not found in API response", instanceId)
+	}
+	d.SetId(backupId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			backup, err := getDdsBackup(client, instanceId, backupId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", backup, "").(string)
+			if status == "FAILED" {
+				return backup, status, fmt.Errorf("the backup is failed")
+			}
+			return backup, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDS backup (%s) to be completed: %s", backupId, err)
+	}
+	return resourceDdsBackupRead(ctx, d, meta)
+}
+
+func getDdsBackup(client *golangsdk.ServiceClient, instanceId, backupId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("backups") + fmt.Sprintf("?instance_id=%s&backup_id=%s", instanceId, backupId)
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := utils.PathSearch(fmt.Sprintf("backups[?id=='%s']|[0]", backupId), respBody, nil)
+	if backup == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return backup, nil
+}
+
+func resourceDdsBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	backup, err := getDdsBackup(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDS backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", backup, nil)),
+		d.Set("description", utils.PathSearch("description", backup, nil)),
+		d.Set("instance_name", utils.PathSearch("instance_name", backup, nil)),
+		d.Set("type", utils.PathSearch("type", backup, nil)),
+		d.Set("size", utils.PathSearch("size", backup, nil)),
+		d.Set("status", utils.PathSearch("status", backup, nil)),
+		d.Set("begin_time", utils.PathSearch("begin_time", backup, nil)),
+		d.Set("end_time", utils.PathSearch("end_time", backup, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving DDS backup fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDdsBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+	}
+	if _, err = client.Request("DELETE", client.ServiceURL("backups", d.Id()), &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDS backup")
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			backup, err := getDdsBackup(client, instanceId, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return backup, "DELETING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDS backup (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceDdsBackupImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<backup_id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,196 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdsInstanceRestore is the impl for huaweicloud_dds_instance_restore resource, which restores a backup or a
+// point in time of the source instance to an existing instance. The data of the target instance is overwritten.
+func ResourceDdsInstanceRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsInstanceRestoreCreate,
+		ReadContext:   resourceDdsInstanceRestoreRead,
+		DeleteContext: resourceDdsInstanceRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "backup",
+				ValidateFunc: validation.StringInSlice([]string{"backup", "timestamp"}, false),
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"restore_time": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDdsInstanceRestoreBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	restoreType := d.Get("type").(string)
+	source := map[string]interface{}{
+		"instance_id": d.Get("source_id"),
+		"type":        restoreType,
+	}
+	switch restoreType {
+	case "backup":
+		backupId, ok := d.GetOk("backup_id")
+		if !ok {
+			return nil, fmt.Errorf("backup_id is required when the type is backup")
+		}
+		source["backup_id"] = backupId
+	case "timestamp":
+		restoreTime, ok := d.GetOk("restore_time")
+		if !ok {
+			return nil, fmt.Errorf("restore_time is required when the type is timestamp")
+		}
+		source["restore_time"] = restoreTime
+	}
+
+	return map[string]interface{}{
+		"source": source,
+		"target": map[string]interface{}{
+			"instance_id": d.Get("target_id"),
+		},
+	}, nil
+}
+
+func resourceDdsInstanceRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	restoreBody, err := buildDdsInstanceRestoreBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetId := d.Get("target_id").(string)
+	restoreOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody:         restoreBody,
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances", "recovery"), &restoreOpt)
+	if err != nil {
+		return diag.Errorf("error restoring DDS instance (%s): %s", targetId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobId := utils.PathSearch("job_id", respBody, "").(string)
+	if jobId == "" {
+		return diag.Errorf("error restoring DDS instance (%s): job ID is not found in API response", targetId)
+	}
+	d.SetId(jobId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Running"},
+		Target:       []string{"Completed"},
+		Refresh:      JobStateRefreshFunc(client, jobId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        20 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDS instance (%s) restoration job (%s) to be completed: %s",
+			targetId, jobId, err)
+	}
+	if err = waitForInstanceReady(ctx, client, targetId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDdsInstanceRestoreRead(ctx, d, meta)
+}
+
+func resourceDdsInstanceRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	// The restoration is removed from the state if the target instance is deleted.
+	_, instanceStatus, err := DdsInstanceStateRefreshFunc(client, d.Get("target_id").(string))()
+	if err != nil {
+		return diag.Errorf("error retrieving DDS instance: %s", err)
+	}
+	if instanceStatus == "deleted" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "DDS instance restore")
+	}
+
+	status := d.Get("status").(string)
+	// The job records are only retained for a limited period, so the status is kept if the job is not found.
+	if _, jobStatus, err := JobStateRefreshFunc(client, d.Id())(); err == nil && jobStatus != "" {
+		status = jobStatus
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("status", status),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving DDS instance restore fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDdsInstanceRestoreDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the DDS instance restore is not supported. The restore is only removed from the state, " +
+		"the restored data remains in the target instance."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
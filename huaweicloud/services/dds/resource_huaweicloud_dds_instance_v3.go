@@ -807,42 +807,6 @@ func getDdsInstanceV3ShardGroupID(client *golangsdk.ServiceClient, d *schema.Res
 
 }
 
-func getDdsInstanceV3MongosNodeID(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]string, error) {
-	nodeIDs := make([]string, 0)
-
-	instanceID := d.Id()
-	opts := instances.ListInstanceOpts{
-		Id: instanceID,
-	}
-	allPages, err := instances.List(client, &opts).AllPages()
-	if err != nil {
-		return nodeIDs, fmtp.Errorf("Error fetching DDS instance: %s", err)
-	}
-	instances, err := instances.ExtractInstances(allPages)
-	if err != nil {
-		return nodeIDs, fmtp.Errorf("Error extracting DDS instance: %s", err)
-	}
-	if instances.TotalCount == 0 {
-		logp.Printf("[WARN] DDS instance (%s) was not found", instanceID)
-		return nodeIDs, nil
-	}
-	insts := instances.Instances
-	instance := insts[0]
-
-	logp.Printf("[DEBUG] Retrieved instance %s: %#v", instanceID, instance)
-
-	for _, group := range instance.Groups {
-		if group.Type == "mongos" {
-			for _, node := range group.Nodes {
-				nodeIDs = append(nodeIDs, node.Id)
-			}
-		}
-	}
-
-	return nodeIDs, nil
-
-}
-
 func flavorUpdate(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient, d *schema.ResourceData,
 	opts []instances.UpdateOpt) error {
 	resp, err := instances.Update(client, d.Id(), opts).Extract()
@@ -883,7 +847,7 @@ func flavorNumUpdate(ctx context.Context, config *config.Config, client *golangs
 	oldNum := oldNumRaw.(int)
 	newNum := newNumRaw.(int)
 	if newNum < oldNum {
-		return fmtp.Errorf("Error updating instance: the new num(%d) must be greater than the old num(%d)", newNum, oldNum)
+		return flavorNumReduce(ctx, client, d, groupType, oldNum-newNum)
 	}
 
 	var numUpdateOpts []instances.UpdateOpt
@@ -1009,52 +973,22 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 	specCodeIndex := fmt.Sprintf("flavor.%d.spec_code", i)
 	groupTypeIndex := fmt.Sprintf("flavor.%d.type", i)
 	groupType := d.Get(groupTypeIndex).(string)
-	if groupType == "config" {
-		return fmtp.Errorf("Error updating instance: %s does not support updating spec_code", groupType)
-	}
-	if groupType == "mongos" {
-		nodeIDs, err := getDdsInstanceV3MongosNodeID(client, d)
-		if err != nil {
-			return err
-		}
-		for _, ID := range nodeIDs {
-			var specUpdateOpts []instances.UpdateOpt
-			updateSpecOpts := instances.UpdateSpecOpts{
-				Resize: instances.SpecOpts{
-					TargetType:     "mongos",
-					TargetID:       ID,
-					TargetSpecCode: d.Get(specCodeIndex).(string),
-				},
-			}
-			if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
-				updateSpecOpts.IsAutoPay = true
-
-			}
-			opt := instances.UpdateOpt{
-				Param:  "",
-				Value:  updateSpecOpts,
-				Action: "resize",
-				Method: "post",
-			}
-			specUpdateOpts = append(specUpdateOpts, opt)
-			err := flavorUpdate(ctx, config, client, d, specUpdateOpts)
-			if err != nil {
-				return err
-			}
-		}
-	} else if groupType == "shard" {
-		groupIDs, err := getDdsInstanceV3ShardGroupID(client, d)
+	specCode := d.Get(specCodeIndex).(string)
+	if groupType == "mongos" || groupType == "shard" || groupType == "config" {
+		// The mongos nodes are resized one by one, and the shard and config nodes are resized by group. The nodes or
+		// groups already using the new spec code are skipped, so that a failed update can be retried.
+		targetIDs, err := getDdsInstanceV3ResizeTargetIDs(client, d, groupType, specCode)
 		if err != nil {
 			return err
 		}
 
-		for _, ID := range groupIDs {
+		for _, ID := range targetIDs {
 			var specUpdateOpts []instances.UpdateOpt
 			updateSpecOpts := instances.UpdateSpecOpts{
 				Resize: instances.SpecOpts{
-					TargetType:     "shard",
+					TargetType:     groupType,
 					TargetID:       ID,
-					TargetSpecCode: d.Get(specCodeIndex).(string),
+					TargetSpecCode: specCode,
 				},
 			}
 			if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
@@ -1062,7 +996,7 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 
 			}
 			opt := instances.UpdateOpt{
-				Param:  "resize",
+				Param:  "",
 				Value:  updateSpecOpts,
 				Action: "resize",
 				Method: "post",
@@ -1078,7 +1012,7 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 		updateSpecOpts := instances.UpdateSpecOpts{
 			Resize: instances.SpecOpts{
 				TargetID:       d.Id(),
-				TargetSpecCode: d.Get(specCodeIndex).(string),
+				TargetSpecCode: specCode,
 			},
 		}
 		if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
@@ -1099,3 +1033,112 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 	}
 	return nil
 }
+
+func getDdsInstanceV3Groups(client *golangsdk.ServiceClient, instanceID, groupType string) ([]instances.Group, error) {
+	opts := instances.ListInstanceOpts{
+		Id: instanceID,
+	}
+	allPages, err := instances.List(client, &opts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	instanceList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting DDS instance: %s", err)
+	}
+	if instanceList.TotalCount == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+
+	groups := make([]instances.Group, 0)
+	for _, group := range instanceList.Instances[0].Groups {
+		if group.Type == groupType {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// getDdsInstanceV3ResizeTargetIDs returns the IDs of the mongos nodes, or the IDs of the shard or config groups, which
+// are not using the spec code.
+func getDdsInstanceV3ResizeTargetIDs(client *golangsdk.ServiceClient, d *schema.ResourceData, groupType,
+	specCode string) ([]string, error) {
+	groups, err := getDdsInstanceV3Groups(client, d.Id(), groupType)
+	if err != nil {
+		return nil, err
+	}
+
+	targetIDs := make([]string, 0)
+	for _, group := range groups {
+		for _, node := range group.Nodes {
+			if node.SpecCode == specCode {
+				continue
+			}
+			if groupType == "mongos" {
+				targetIDs = append(targetIDs, node.Id)
+				continue
+			}
+			targetIDs = append(targetIDs, group.Id)
+			break
+		}
+	}
+	return targetIDs, nil
+}
+
+// flavorNumReduce removes the mongos nodes or the shards from the cluster instance, the latest created nodes or shards
+// are removed first.
+func flavorNumReduce(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, groupType string,
+	num int) error {
+	groups, err := getDdsInstanceV3Groups(client, d.Id(), groupType)
+	if err != nil {
+		return err
+	}
+
+	// The mongos nodes are in one group, and each shard is a group.
+	targetIDs := make([]string, 0)
+	for _, group := range groups {
+		if groupType == "mongos" {
+			for _, node := range group.Nodes {
+				targetIDs = append(targetIDs, node.Id)
+			}
+			continue
+		}
+		targetIDs = append(targetIDs, group.Id)
+	}
+	if num >= len(targetIDs) {
+		return fmt.Errorf("error updating instance: at least one %s must be retained", groupType)
+	}
+
+	reduceOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"type":      groupType,
+			"num":       num,
+			"node_list": targetIDs[len(targetIDs)-num:],
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances", d.Id(), "reduce-node"), &reduceOpt)
+	if err != nil {
+		return fmt.Errorf("error removing %s nodes of the instance (%s): %s", groupType, d.Id(), err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if jobId := utils.PathSearch("job_id", respBody, "").(string); jobId != "" {
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"Running"},
+			Target:       []string{"Completed"},
+			Refresh:      JobStateRefreshFunc(client, jobId),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        10 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for the job (%s) completed: %s", jobId, err)
+		}
+	}
+	return waitForInstanceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
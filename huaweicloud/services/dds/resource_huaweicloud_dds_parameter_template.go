@@ -0,0 +1,220 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdsParameterTemplate is the impl for huaweicloud_dds_parameter_template resource. Only the configured
+// parameters are managed, the other parameters of the template keep the default values.
+func ResourceDdsParameterTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsParameterTemplateCreate,
+		ReadContext:   resourceDdsParameterTemplateRead,
+		UpdateContext: resourceDdsParameterTemplateUpdate,
+		DeleteContext: resourceDdsParameterTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mongos", "shard", "config", "replica", "single",
+				}, false),
+			},
+			"node_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"parameters": common.ParametersSchema(),
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDdsParameterTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	createBody := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": utils.ValueIngoreEmpty(d.Get("description")),
+		"datastore": map[string]interface{}{
+			"datastore_name": "mongodb",
+			"version":        d.Get("node_version"),
+			"node_type":      d.Get("node_type"),
+		},
+	}
+	if values := common.ExpandChangedParameters(d); len(values) > 0 {
+		createBody["parameter_values"] = values
+	}
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         utils.RemoveNil(createBody),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("configurations"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating DDS parameter template: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateId := utils.PathSearch("configuration.id", respBody, "").(string)
+	if templateId == "" {
+		return diag.Errorf("error creating DDS parameter template: ID is not found in API response")
+	}
+	d.SetId(templateId)
+
+	return resourceDdsParameterTemplateRead(ctx, d, meta)
+}
+
+func resourceDdsParameterTemplateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("configurations", d.Id()), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDS parameter template")
+	}
+	template, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := make(map[string]string)
+	for _, param := range utils.PathSearch("parameters", template, make([]interface{}, 0)).([]interface{}) {
+		values[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, "").(string)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", template, nil)),
+		d.Set("description", utils.PathSearch("description", template, nil)),
+		d.Set("node_version", utils.PathSearch("datastore_version", template, nil)),
+		d.Set("parameters", common.FlattenConfiguredParameters(d, values)),
+		d.Set("created_at", utils.PathSearch("created", template, nil)),
+		d.Set("updated_at", utils.PathSearch("updated", template, nil)),
+	)
+	// The node type is not returned by the detail API, it is only returned by the list API.
+	if nodeType, err := getDdsParameterTemplateNodeType(client, d.Id()); err != nil {
+		log.Printf("[WARN] error getting the node type of DDS parameter template (%s): %s", d.Id(), err)
+	} else if nodeType != "" {
+		mErr = multierror.Append(mErr, d.Set("node_type", nodeType))
+	}
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving DDS parameter template fields: %s", err)
+	}
+	return nil
+}
+
+func getDdsParameterTemplateNodeType(client *golangsdk.ServiceClient, templateId string) (string, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("configurations"), &getOpt)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	expression := fmt.Sprintf("configurations[?id=='%s']|[0].node_type", templateId)
+	return utils.PathSearch(expression, respBody, "").(string), nil
+}
+
+func resourceDdsParameterTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	updateBody := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+	}
+	if values := common.ExpandChangedParameters(d); len(values) > 0 {
+		updateBody["parameter_values"] = values
+	}
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody:         updateBody,
+	}
+	if _, err = client.Request("PUT", client.ServiceURL("configurations", d.Id()), &updateOpt); err != nil {
+		return diag.Errorf("error updating DDS parameter template (%s): %s", d.Id(), err)
+	}
+	return resourceDdsParameterTemplateRead(ctx, d, meta)
+}
+
+func resourceDdsParameterTemplateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DdsV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDS v3 client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	if _, err = client.Request("DELETE", client.ServiceURL("configurations", d.Id()), &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDS parameter template")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package dew
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The secret value is not returned if it is not specified, the empty value is saved instead of its hash.
+func hashCsmsSecretValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return utils.HashAndHexEncode(value)
+}
+
+func suppressEquivalentRotationConfigDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+	return equal
+}
+
+func isCsmsSecretRotationConfigured(d *schema.ResourceData) bool {
+	for _, key := range []string{"secret_type", "rotation_config", "auto_rotation", "rotation_period",
+		"event_subscriptions"} {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// createCsmsSecretWithRotation creates the secret with the secret type, the rotation configuration and the event
+// subscriptions, which are not supported by the secrets package.
+func createCsmsSecretWithRotation(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	opts secrets.CreateSecretOpts) (*secrets.Secret, error) {
+	createPath := client.ServiceURL("secrets")
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"name":                opts.Name,
+			"kms_key_id":          utils.ValueIngoreEmpty(opts.KmsKeyID),
+			"description":         utils.ValueIngoreEmpty(opts.Description),
+			"secret_string":       utils.ValueIngoreEmpty(opts.SecretString),
+			"secret_binary":       utils.ValueIngoreEmpty(opts.SecretBinary),
+			"secret_type":         utils.ValueIngoreEmpty(d.Get("secret_type")),
+			"rotation_config":     utils.ValueIngoreEmpty(d.Get("rotation_config")),
+			"auto_rotation":       utils.ValueIngoreEmpty(d.Get("auto_rotation")),
+			"rotation_period":     utils.ValueIngoreEmpty(d.Get("rotation_period")),
+			"event_subscriptions": utils.ValueIngoreEmpty(d.Get("event_subscriptions")),
+		}),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	secretId := utils.PathSearch("secret.id", respBody, "").(string)
+	if secretId == "" {
+		return nil, fmt.Errorf("unable to find the secret ID from the API response")
+	}
+	return &secrets.Secret{ID: secretId, Name: opts.Name}, nil
+}
+
+// updateCsmsSecretRotation updates the automatic rotation and the event subscriptions of the secret. The rotation
+// period is kept if the automatic rotation is disabled, and the event subscriptions are cleared if they are removed.
+func updateCsmsSecretRotation(client *golangsdk.ServiceClient, d *schema.ResourceData, name string) error {
+	updatePath := client.ServiceURL("secrets", name)
+	params := map[string]interface{}{
+		"auto_rotation":       d.Get("auto_rotation"),
+		"event_subscriptions": utils.ExpandToStringList(d.Get("event_subscriptions").([]interface{})),
+	}
+	if v, ok := d.GetOk("rotation_period"); ok {
+		params["rotation_period"] = v
+	}
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+		JSONBody:         params,
+	}
+	_, err := client.Request("PUT", updatePath, &updateOpt)
+	return err
+}
+
+func formatCsmsSecretTime(v interface{}) string {
+	timestamp, ok := v.(float64)
+	if !ok || timestamp == 0 {
+		return ""
+	}
+	return time.Unix(int64(timestamp)/1000, 0).UTC().Format("2006-01-02 15:04:05 MST")
+}
+
+// getCsmsSecret queries the secret details by the raw request, the rotation fields are not parsed by the secrets
+// package.
+func getCsmsSecret(client *golangsdk.ServiceClient, name string) (interface{}, error) {
+	getPath := client.ServiceURL("secrets", name)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// setCsmsSecretRotationFields saves the rotation fields from the secret details.
+func setCsmsSecretRotationFields(d *schema.ResourceData, respBody interface{}) error {
+	mErr := multierror.Append(nil,
+		d.Set("secret_type", utils.PathSearch("secret.secret_type", respBody, nil)),
+		d.Set("auto_rotation", utils.PathSearch("secret.auto_rotation", respBody, false)),
+		d.Set("rotation_period", utils.PathSearch("secret.rotation_period", respBody, nil)),
+		d.Set("event_subscriptions", utils.PathSearch("secret.event_subscriptions", respBody, nil)),
+		d.Set("rotation_time", formatCsmsSecretTime(utils.PathSearch("secret.rotation_time", respBody, nil))),
+		d.Set("next_rotation_time",
+			formatCsmsSecretTime(utils.PathSearch("secret.next_rotation_time", respBody, nil))),
+	)
+	if rotationConfig := utils.PathSearch("secret.rotation_config", respBody, "").(string); rotationConfig != "" {
+		mErr = multierror.Append(mErr, d.Set("rotation_config", rotationConfig))
+	}
+	return mErr.ErrorOrNil()
+}
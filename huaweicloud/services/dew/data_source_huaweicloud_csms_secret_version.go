@@ -38,6 +38,11 @@ func DataSourceDewCsmsSecret() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"secret_binary": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -80,6 +85,7 @@ func dataSourceDewCsmsSecretRead(_ context.Context, d *schema.ResourceData, meta
 	mErr := multierror.Append(
 		d.Set("region", region),
 		d.Set("secret_text", version.SecretString),
+		d.Set("secret_binary", version.SecretBinary),
 		d.Set("secret_name", vMetadata.SecretName),
 		d.Set("kms_key_id", vMetadata.KmsKeyID),
 		d.Set("version", vMetadata.ID),
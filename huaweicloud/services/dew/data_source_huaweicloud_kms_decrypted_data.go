@@ -0,0 +1,102 @@
+package dew
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceKmsDecryptedData is the impl of data/huaweicloud_kms_decrypted_data, which decrypts the cipher text
+// encrypted by the KMS key.
+func DataSourceKmsDecryptedData() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsDecryptedDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cipher_text": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kmsEncryptionAlgorithms, false),
+			},
+			"plain_text": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"plain_text_base64": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsDecryptedDataRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	cipherText := d.Get("cipher_text").(string)
+	decryptOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"cipher_text":          cipherText,
+			"key_id":               utils.ValueIngoreEmpty(d.Get("key_id")),
+			"encryption_context":   utils.ValueIngoreEmpty(d.Get("encryption_context")),
+			"encryption_algorithm": utils.ValueIngoreEmpty(d.Get("encryption_algorithm")),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "decrypt-data"), &decryptOpt)
+	if err != nil {
+		return diag.Errorf("error decrypting data with KMS: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(cipherText)))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("key_id", utils.PathSearch("key_id", respBody, nil)),
+		d.Set("plain_text", utils.PathSearch("plain_text", respBody, nil)),
+		d.Set("plain_text_base64", utils.PathSearch("plain_text_base64", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS decrypted data fields: %s", err)
+	}
+	return nil
+}
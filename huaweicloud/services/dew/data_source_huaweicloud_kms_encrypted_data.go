@@ -0,0 +1,98 @@
+package dew
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+var kmsEncryptionAlgorithms = []string{"SYMMETRIC_DEFAULT", "RSAES_OAEP_SHA_1", "RSAES_OAEP_SHA_256", "SM2_ENCRYPT"}
+
+// DataSourceKmsEncryptedData is the impl of data/huaweicloud_kms_encrypted_data, which encrypts the small payload
+// (up to 4 KB) with the KMS key.
+func DataSourceKmsEncryptedData() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsEncryptedDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plain_text": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kmsEncryptionAlgorithms, false),
+			},
+			"cipher_text": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsEncryptedDataRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId := d.Get("key_id").(string)
+	plainText := d.Get("plain_text").(string)
+	encryptOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"key_id":               keyId,
+			"plain_text":           plainText,
+			"encryption_context":   utils.ValueIngoreEmpty(d.Get("encryption_context")),
+			"encryption_algorithm": utils.ValueIngoreEmpty(d.Get("encryption_algorithm")),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "encrypt-data"), &encryptOpt)
+	if err != nil {
+		return diag.Errorf("error encrypting data with KMS key (%s): %s", keyId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(keyId + plainText)))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cipher_text", utils.PathSearch("cipher_text", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS encrypted data fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package dew
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+var (
+	kmsSigningAlgorithms = []string{
+		"RSASSA_PSS_SHA_256", "RSASSA_PSS_SHA_384", "RSASSA_PSS_SHA_512",
+		"RSASSA_PKCS1_V1_5_SHA_256", "RSASSA_PKCS1_V1_5_SHA_384", "RSASSA_PKCS1_V1_5_SHA_512",
+		"ECDSA_SHA_256", "ECDSA_SHA_384", "ECDSA_SHA_512", "SM2DSA_SM3",
+	}
+	kmsMessageTypes = []string{"RAW", "DIGEST"}
+)
+
+// DataSourceKmsSignature is the impl of data/huaweicloud_kms_signature, which signs the message with the asymmetric
+// KMS key whose usage is SIGN_VERIFY.
+func DataSourceKmsSignature() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"signing_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kmsSigningAlgorithms, false),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RAW",
+				ValidateFunc: validation.StringInSlice(kmsMessageTypes, false),
+			},
+			"signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSignatureRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId := d.Get("key_id").(string)
+	message := d.Get("message").(string)
+	signOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id":            keyId,
+			"message":           message,
+			"signing_algorithm": d.Get("signing_algorithm"),
+			"message_type":      d.Get("message_type"),
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "sign"), &signOpt)
+	if err != nil {
+		return diag.Errorf("error signing message with KMS key (%s): %s", keyId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(keyId + message + d.Get("signing_algorithm").(string))))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("signature", utils.PathSearch("signature", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS signature fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package dew
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceKmsSignatureVerification is the impl of data/huaweicloud_kms_signature_verification, which verifies the
+// signature of the message with the asymmetric KMS key whose usage is SIGN_VERIFY.
+func DataSourceKmsSignatureVerification() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKmsSignatureVerificationRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"signature": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"signing_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kmsSigningAlgorithms, false),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RAW",
+				ValidateFunc: validation.StringInSlice(kmsMessageTypes, false),
+			},
+			"signature_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSignatureVerificationRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId := d.Get("key_id").(string)
+	signature := d.Get("signature").(string)
+	verifyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id":            keyId,
+			"message":           d.Get("message"),
+			"signature":         signature,
+			"signing_algorithm": d.Get("signing_algorithm"),
+			"message_type":      d.Get("message_type"),
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "verify"), &verifyOpt)
+	if err != nil {
+		return diag.Errorf("error verifying signature with KMS key (%s): %s", keyId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(keyId + signature)))
+
+	// The verification result is returned as a string.
+	signatureValid := utils.PathSearch("signature_valid", respBody, "").(string) == "true"
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("signature_valid", signatureValid),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS signature verification fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package dew
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCsmsEvent is the impl of huaweicloud_csms_event, which sends the notifications of the secret events to the
+// SMN topic. The event is bound to the secrets through their event_subscriptions.
+func ResourceCsmsEvent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsEventCreate,
+		ReadContext:   resourceCsmsEventRead,
+		UpdateContext: resourceCsmsEventUpdate,
+		DeleteContext: resourceCsmsEventDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SECRET_VERSION_CREATED", "SECRET_VERSION_EXPIRED", "SECRET_ROTATED", "SECRET_DELETED",
+					}, false),
+				},
+			},
+			"smn_topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"smn_topic_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false),
+			},
+			"event_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCsmsEventBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"event_types": utils.ExpandToStringList(d.Get("event_types").([]interface{})),
+		"state":       d.Get("status"),
+		"notification": map[string]interface{}{
+			"target_type": "SMN",
+			"target_id":   d.Get("smn_topic_urn"),
+			"target_name": d.Get("smn_topic_name"),
+		},
+	}
+}
+
+func resourceCsmsEventCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	// The endpoint of CSMS is the endpoint of KMS.
+	client, err := cfg.KmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	params := buildCsmsEventBodyParams(d)
+	params["name"] = name
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+		JSONBody:         params,
+	}
+	if _, err = client.Request("POST", client.ServiceURL("csms", "events"), &createOpt); err != nil {
+		return diag.Errorf("error creating CSMS event: %s", err)
+	}
+
+	// The event is identified by its name in the APIs.
+	d.SetId(name)
+	return resourceCsmsEventRead(ctx, d, meta)
+}
+
+func resourceCsmsEventRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	resp, err := client.Request("GET", client.ServiceURL("csms", "events", d.Id()), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSMS event")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("event.name", respBody, nil)),
+		d.Set("event_types", utils.PathSearch("event.event_types", respBody, nil)),
+		d.Set("smn_topic_urn", utils.PathSearch("event.notification.target_id", respBody, nil)),
+		d.Set("smn_topic_name", utils.PathSearch("event.notification.target_name", respBody, nil)),
+		d.Set("status", utils.PathSearch("event.state", respBody, nil)),
+		d.Set("event_id", utils.PathSearch("event.event_id", respBody, nil)),
+		d.Set("created_at", formatCsmsSecretTime(utils.PathSearch("event.create_time", respBody, nil))),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSMS event fields: %s", err)
+	}
+	return nil
+}
+
+func resourceCsmsEventUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+		JSONBody:         buildCsmsEventBodyParams(d),
+	}
+	if _, err = client.Request("PUT", client.ServiceURL("csms", "events", d.Id()), &updateOpt); err != nil {
+		return diag.Errorf("error updating CSMS event: %s", err)
+	}
+	return resourceCsmsEventRead(ctx, d, meta)
+}
+
+func resourceCsmsEventDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	if _, err = client.Request("DELETE", client.ServiceURL("csms", "events", d.Id()), &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSMS event")
+	}
+	return nil
+}
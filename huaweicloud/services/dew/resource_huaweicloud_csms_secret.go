@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/chnsz/golangsdk/openstack/common/tags"
 	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
@@ -52,10 +51,18 @@ func ResourceCsmsSecret() *schema.Resource {
 						"Only letters, digits, underscores (_) hyphens (-) and dots (.) are allowed."),
 			},
 			"secret_text": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
-				StateFunc: utils.HashAndHexEncode,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				StateFunc:    utils.HashAndHexEncode,
+				ExactlyOneOf: []string{"secret_text", "secret_binary"},
+			},
+			"secret_binary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				StateFunc:    utils.HashAndHexEncode,
+				ValidateFunc: validation.StringIsBase64,
 			},
 			"kms_key_id": {
 				Type:     schema.TypeString,
@@ -67,6 +74,46 @@ func ResourceCsmsSecret() *schema.Resource {
 				Optional: true,
 			},
 			"tags": common.TagsSchema(),
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"COMMON", "RDS-FG", "GaussDB-FG"}, false),
+			},
+			"rotation_config": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentRotationConfigDiffs,
+			},
+			"auto_rotation": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"rotation_period"},
+			},
+			"rotation_period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9]\d*[dh]$`),
+					"The rotation period must be a number of days (d) or hours (h), e.g. 30d or 6h."),
+			},
+			"event_subscriptions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_rotation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"secret_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,8 +151,14 @@ func resourceCsmsSecretCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 	logp.Printf("[DEBUG] Create CSMS secret options: %s", createOpts)
 	createOpts.SecretString = d.Get("secret_text").(string)
+	createOpts.SecretBinary = d.Get("secret_binary").(string)
 
-	rst, err := secrets.Create(client, createOpts)
+	var rst *secrets.Secret
+	if isCsmsSecretRotationConfigured(d) {
+		rst, err = createCsmsSecretWithRotation(client, d, createOpts)
+	} else {
+		rst, err = secrets.Create(client, createOpts)
+	}
 	if err != nil {
 		return fmtp.DiagErrorf("failed to create the CSMS secret: %s", err)
 	}
@@ -137,20 +190,20 @@ func resourceCsmsSecretRead(_ context.Context, d *schema.ResourceData, meta inte
 
 	id, name := parseID(d.Id())
 	// Query secret details
-	secret, err := secrets.Get(client, name)
+	secret, err := getCsmsSecret(client, name)
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "failed to query CSMS secret details")
 	}
 
-	createTime := time.Unix(int64(secret.CreateTime)/1000, 0).UTC().Format("2006-01-02 15:04:05 MST")
 	mErr := multierror.Append(
+		setCsmsSecretRotationFields(d, secret),
 		d.Set("region", region),
-		d.Set("secret_id", secret.ID),
-		d.Set("name", secret.Name),
-		d.Set("kms_key_id", secret.KmsKeyID),
-		d.Set("description", secret.Description),
-		d.Set("status", secret.State),
-		d.Set("create_time", createTime),
+		d.Set("secret_id", utils.PathSearch("secret.id", secret, nil)),
+		d.Set("name", utils.PathSearch("secret.name", secret, nil)),
+		d.Set("kms_key_id", utils.PathSearch("secret.kms_key_id", secret, nil)),
+		d.Set("description", utils.PathSearch("secret.description", secret, nil)),
+		d.Set("status", utils.PathSearch("secret.state", secret, nil)),
+		d.Set("create_time", formatCsmsSecretTime(utils.PathSearch("secret.create_time", secret, nil))),
 	)
 
 	// Query secret version
@@ -160,12 +213,11 @@ func resourceCsmsSecretRead(_ context.Context, d *schema.ResourceData, meta inte
 			mErr,
 			err)
 	}
-	secretTxt := version.SecretString
-	encodedSecretTxt := utils.HashAndHexEncode(secretTxt)
 	versionID := version.VersionMetadata.ID
 	mErr = multierror.Append(
 		mErr,
-		d.Set("secret_text", encodedSecretTxt),
+		d.Set("secret_text", hashCsmsSecretValue(version.SecretString)),
+		d.Set("secret_binary", hashCsmsSecretValue(version.SecretBinary)),
 		d.Set("latest_version", versionID),
 	)
 
@@ -249,10 +301,20 @@ func resourceCsmsSecretUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Update secret rotation and event subscriptions
+	if d.HasChanges("auto_rotation", "rotation_period", "event_subscriptions") {
+		err = updateCsmsSecretRotation(client, d, name)
+		if err != nil {
+			e := fmtp.Errorf("failed to update the rotation of CSMS secret: %s", err)
+			mErr = multierror.Append(mErr, e)
+		}
+	}
+
 	// Update secret text
-	if d.HasChanges("secret_text") {
+	if d.HasChanges("secret_text", "secret_binary") {
 		opts := secrets.CreateVersionOpts{
 			SecretString: d.Get("secret_text").(string),
+			SecretBinary: d.Get("secret_binary").(string),
 		}
 		_, err = secrets.CreateSecretVersion(client, name, opts)
 		if err != nil {
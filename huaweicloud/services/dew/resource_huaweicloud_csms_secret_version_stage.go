@@ -0,0 +1,166 @@
+package dew
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/csms/v1/secrets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The system stages are managed by CSMS, they cannot be deleted and can only be moved to another version.
+var csmsSecretSystemStages = []string{"SYSCURRENT", "SYSPREVIOUS"}
+
+// ResourceCsmsSecretVersionStage is the impl of huaweicloud_csms_secret_version_stage, which labels a secret version
+// with the stage name.
+func ResourceCsmsSecretVersionStage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCsmsSecretVersionStageCreateOrUpdate,
+		ReadContext:   resourceCsmsSecretVersionStageRead,
+		UpdateContext: resourceCsmsSecretVersionStageCreateOrUpdate,
+		DeleteContext: resourceCsmsSecretVersionStageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCsmsSecretVersionStageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secret_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCsmsSecretVersionStageCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	// The endpoint of CSMS is the endpoint of KMS.
+	client, err := cfg.KmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	stageName := d.Get("name").(string)
+	updatePath := client.ServiceURL("secrets", secretName, "stages", stageName)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+		JSONBody: map[string]interface{}{
+			"version_id": d.Get("version_id"),
+		},
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error setting the stage (%s) of CSMS secret (%s): %s", stageName, secretName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s/%s", secretName, stageName))
+	}
+	return resourceCsmsSecretVersionStageRead(ctx, d, meta)
+}
+
+func resourceCsmsSecretVersionStageRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	secretName := d.Get("secret_name").(string)
+	stageName := d.Get("name").(string)
+	getPath := client.ServiceURL("secrets", secretName, "stages", stageName)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSMS secret version stage")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("version_id", utils.PathSearch("stage.version_id", respBody, nil)),
+		d.Set("updated_at", formatCsmsSecretTime(utils.PathSearch("stage.update_time", respBody, nil))),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSMS secret version stage fields: %s", err)
+	}
+	return nil
+}
+
+func resourceCsmsSecretVersionStageDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	stageName := d.Get("name").(string)
+	if utils.StrSliceContains(csmsSecretSystemStages, stageName) {
+		log.Printf("[WARN] the system stage (%s) cannot be deleted, it is only removed from the state", stageName)
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSMS(KMS) client: %s", err)
+	}
+
+	deletePath := client.ServiceURL("secrets", d.Get("secret_name").(string), "stages", stageName)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      secrets.RequestOpts.MoreHeaders,
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSMS secret version stage")
+	}
+	return nil
+}
+
+func resourceCsmsSecretVersionStageImport(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <secret_name>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("secret_name", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
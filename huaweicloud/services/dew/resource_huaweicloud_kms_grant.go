@@ -0,0 +1,228 @@
+package dew
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+var regexpKmsGrantName = regexp.MustCompile(`^[\w:/-]+$`)
+
+var kmsGrantOperations = []string{
+	"create-datakey", "create-datakey-without-plaintext", "encrypt-datakey", "decrypt-datakey", "describe-key",
+	"create-grant", "retire-grant", "encrypt-data", "decrypt-data", "sign", "verify", "get-publickey",
+}
+
+// ResourceKmsGrant is the impl of huaweicloud_kms_grant, which grants the operations of the KMS key to another user
+// or account. The grant cannot be updated, all changes create a new grant.
+func ResourceKmsGrant() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKmsGrantCreate,
+		ReadContext:   resourceKmsGrantRead,
+		DeleteContext: resourceKmsGrantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKmsGrantImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(kmsGrantOperations, false),
+				},
+			},
+			"grantee_principal_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "user",
+				ValidateFunc: validation.StringInSlice([]string{"user", "domain"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexpKmsGrantName, "Only letters, digits, underscores (_), hyphens (-), "+
+						"colons (:) and slashes (/) are allowed."),
+				),
+			},
+			"retiring_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsKeyV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId := d.Get("key_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"key_id":                 keyId,
+			"grantee_principal":      d.Get("grantee_principal"),
+			"grantee_principal_type": d.Get("grantee_principal_type"),
+			"operations":             utils.ExpandToStringListBySet(d.Get("operations").(*schema.Set)),
+			"name":                   utils.ValueIngoreEmpty(d.Get("name")),
+			"retiring_principal":     utils.ValueIngoreEmpty(d.Get("retiring_principal")),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "create-grant"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating KMS grant: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grantId := utils.PathSearch("grant_id", respBody, "").(string)
+	if grantId == "" {
+		return diag.Errorf("unable to find the KMS grant ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", keyId, grantId))
+
+	return resourceKmsGrantRead(ctx, d, meta)
+}
+
+// getKmsGrant returns the grant of the key, the grants are listed by pages.
+func getKmsGrant(client *golangsdk.ServiceClient, keyId, grantId string) (interface{}, error) {
+	listPath := client.ServiceURL(client.ProjectID, "kms", "list-grants")
+	params := map[string]interface{}{
+		"key_id": keyId,
+		"limit":  "100",
+	}
+	for {
+		listOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         params,
+		}
+		resp, err := client.Request("POST", listPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		grant := utils.PathSearch(fmt.Sprintf("grants[?grant_id=='%s']|[0]", grantId), respBody, nil)
+		if grant != nil {
+			return grant, nil
+		}
+		marker := utils.PathSearch("next_marker", respBody, "").(string)
+		// The truncated flag is returned as a string.
+		if fmt.Sprint(utils.PathSearch("truncated", respBody, false)) != "true" || marker == "" {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		params["marker"] = marker
+	}
+}
+
+func resourceKmsGrantRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId, grantId := parseID(d.Id())
+	grant, err := getKmsGrant(client, keyId, grantId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving KMS grant")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("key_id", utils.PathSearch("key_id", grant, nil)),
+		d.Set("grantee_principal", utils.PathSearch("grantee_principal", grant, nil)),
+		d.Set("grantee_principal_type", utils.PathSearch("grantee_principal_type", grant, nil)),
+		d.Set("operations", utils.PathSearch("operations", grant, nil)),
+		d.Set("name", utils.PathSearch("name", grant, nil)),
+		d.Set("retiring_principal", utils.PathSearch("retiring_principal", grant, nil)),
+		d.Set("creator", utils.PathSearch("issuing_principal", grant, nil)),
+		d.Set("created_at", utils.PathSearch("creation_date", grant, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS grant fields: %s", err)
+	}
+	return nil
+}
+
+func resourceKmsGrantDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsKeyV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId, grantId := parseID(d.Id())
+	revokeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id":   keyId,
+			"grant_id": grantId,
+		},
+	}
+	_, err = client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "revoke-grant"), &revokeOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error revoking KMS grant")
+	}
+	return nil
+}
+
+func resourceKmsGrantImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.SplitN(d.Id(), "/", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <key_id>/<grant_id>")
+	}
+	return []*schema.ResourceData{d}, nil
+}
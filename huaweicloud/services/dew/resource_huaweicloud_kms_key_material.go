@@ -0,0 +1,218 @@
+package dew
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/kms/v1/keys"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The key states of the KMS key which is pending deletion or waiting for the key material import.
+const (
+	kmsKeyPendingDeletionState = "4"
+	kmsKeyPendingImportState   = "5"
+)
+
+// ResourceKmsKeyMaterial is the impl of huaweicloud_kms_key_material, which imports the external key material (BYOK)
+// into the KMS key with external origin. The key material is encrypted locally by the wrapping key downloaded from KMS,
+// and is deleted from the KMS key when the resource is destroyed.
+func ResourceKmsKeyMaterial() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKmsKeyMaterialCreate,
+		ReadContext:   resourceKmsKeyMaterialRead,
+		DeleteContext: resourceKmsKeyMaterialDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_material": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				StateFunc:    utils.HashAndHexEncode,
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"wrapping_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RSAES_OAEP_SHA_256",
+				ValidateFunc: validation.StringInSlice([]string{"RSAES_OAEP_SHA_1", "RSAES_OAEP_SHA_256"}, false),
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// encryptKmsKeyMaterial encrypts the key material with the wrapping key in base64 encoded DER format.
+func encryptKmsKeyMaterial(material, publicKey, algorithm string) (string, error) {
+	plaintext, err := base64.StdEncoding.DecodeString(material)
+	if err != nil {
+		return "", fmt.Errorf("error decoding the key material: %s", err)
+	}
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("error decoding the wrapping key: %s", err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return "", fmt.Errorf("error parsing the wrapping key: %s", err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("the wrapping key is not an RSA public key")
+	}
+
+	var h hash.Hash = sha256.New()
+	if algorithm == "RSAES_OAEP_SHA_1" {
+		h = sha1.New()
+	}
+	ciphertext, err := rsa.EncryptOAEP(h, rand.Reader, rsaPub, plaintext, nil)
+	if err != nil {
+		return "", fmt.Errorf("error encrypting the key material: %s", err)
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func resourceKmsKeyMaterialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsKeyV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	keyId := d.Get("key_id").(string)
+	algorithm := d.Get("wrapping_algorithm").(string)
+	// Download the wrapping key and the import token.
+	getParamsOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id":             keyId,
+			"wrapping_algorithm": algorithm,
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "get-parameters-for-import"),
+		&getParamsOpt)
+	if err != nil {
+		return diag.Errorf("error getting the parameters for importing KMS key material: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	encryptedMaterial, err := encryptKmsKeyMaterial(d.Get("key_material").(string),
+		utils.PathSearch("public_key", respBody, "").(string), algorithm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	importOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"key_id":                 keyId,
+			"import_token":           utils.PathSearch("import_token", respBody, nil),
+			"encrypted_key_material": encryptedMaterial,
+			"expiration_time":        utils.ValueIngoreEmpty(d.Get("expiration_time")),
+		}),
+	}
+	_, err = client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "import-key-material"), &importOpt)
+	if err != nil {
+		return diag.Errorf("error importing KMS key material: %s", err)
+	}
+
+	d.SetId(keyId)
+	return resourceKmsKeyMaterialRead(ctx, d, meta)
+}
+
+func resourceKmsKeyMaterialRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.KmsKeyV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	key, err := keys.Get(client, d.Id()).ExtractKeyInfo()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving KMS key")
+	}
+	// The key material is deleted or expired if the key is waiting for the import again.
+	if key.KeyState == kmsKeyPendingImportState || key.KeyState == kmsKeyPendingDeletionState {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "KMS key material")
+	}
+
+	expirationTime := key.ExpirationTime
+	// The expiration time of the key is in milliseconds, but the import parameter is in seconds.
+	if ms, err := strconv.ParseInt(expirationTime, 10, 64); err == nil && ms > 0 {
+		expirationTime = strconv.FormatInt(ms/1000, 10)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("key_id", key.KeyID),
+		d.Set("expiration_time", expirationTime),
+		d.Set("key_state", key.KeyState),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting KMS key material fields: %s", err)
+	}
+	return nil
+}
+
+func resourceKmsKeyMaterialDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.KmsKeyV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"key_id": d.Id(),
+		},
+	}
+	_, err = client.Request("POST", client.ServiceURL(client.ProjectID, "kms", "delete-imported-key-material"),
+		&deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting KMS key material")
+	}
+	return nil
+}
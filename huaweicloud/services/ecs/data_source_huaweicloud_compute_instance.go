@@ -47,6 +47,10 @@ func DataSourceComputeInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fixed_ip_v6": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -241,6 +245,9 @@ func dataSourceComputeInstanceRead(_ context.Context, d *schema.ResourceData, me
 	if err != nil {
 		return diag.Errorf("filter ECS instances failed: %s", err)
 	}
+	if ipv6, ok := d.GetOk("fixed_ip_v6"); ok {
+		filterServers = filterEcsInstancesByIPv6(filterServers, ipv6.(string))
+	}
 
 	if len(filterServers) < 1 {
 		return diag.Errorf("Your query returned no results, please change your search criteria and try again.")
@@ -249,13 +256,38 @@ func dataSourceComputeInstanceRead(_ context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Your query returned more than one result, please try a more specific search criteria.")
 	}
 
-	server := allServers[0]
+	server := filterServers[0].(cloudservers.CloudServer)
 	log.Printf("[DEBUG] fetching the ECS instance: %#v", server)
 
 	d.SetId(server.ID)
 	return setEcsInstanceParams(d, conf, ecsClient, server)
 }
 
+// filterEcsInstancesByIPv6 filters the instances by the fixed IPv6 address, the ECS API only supports the filtering
+// by the IPv4 address.
+func filterEcsInstancesByIPv6(servers []interface{}, ipv6 string) []interface{} {
+	result := make([]interface{}, 0, len(servers))
+	for _, v := range servers {
+		server := v.(cloudservers.CloudServer)
+		for _, addresses := range server.Addresses {
+			if containsEcsInstanceAddress(addresses, "6", ipv6) {
+				result = append(result, server)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func containsEcsInstanceAddress(addresses []cloudservers.Address, version, addr string) bool {
+	for _, address := range addresses {
+		if address.Type != "floating" && address.Version == version && address.Addr == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func setEcsInstanceParams(d *schema.ResourceData, conf *config.Config, ecsClient *golangsdk.ServiceClient,
 	server cloudservers.CloudServer) diag.Diagnostics {
 	region := conf.GetRegion(d)
@@ -377,6 +409,8 @@ func flattenEcsInstanceNetworks(client *golangsdk.ServiceClient,
 	addressResp map[string][]cloudservers.Address) ([]map[string]interface{}, string) {
 	publicIP := ""
 	networks := []map[string]interface{}{}
+	// The IPv4 and IPv6 addresses of the dual-stack NIC are returned separately, they are merged by the port ID.
+	portIndexes := make(map[string]int)
 
 	for _, addresses := range addressResp {
 		for _, addr := range addresses {
@@ -384,6 +418,14 @@ func flattenEcsInstanceNetworks(client *golangsdk.ServiceClient,
 				publicIP = addr.Addr
 				continue
 			}
+			if index, ok := portIndexes[addr.PortID]; ok && addr.PortID != "" {
+				if addr.Version == "6" {
+					networks[index]["fixed_ip_v6"] = addr.Addr
+				} else {
+					networks[index]["fixed_ip_v4"] = addr.Addr
+				}
+				continue
+			}
 
 			// get networkID
 			var networkID string
@@ -405,6 +447,7 @@ func flattenEcsInstanceNetworks(client *golangsdk.ServiceClient,
 				v["fixed_ip_v4"] = addr.Addr
 			}
 
+			portIndexes[addr.PortID] = len(networks)
 			networks = append(networks, v)
 		}
 	}
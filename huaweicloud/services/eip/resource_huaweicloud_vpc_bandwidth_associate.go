@@ -0,0 +1,206 @@
+package eip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	bandwidthsv1 "github.com/chnsz/golangsdk/openstack/networking/v1/bandwidths"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/bandwidths"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// The public IP type of the IPv6 port which is added to the shared bandwidth.
+const ipv6PortPublicIPType = "5_dualStack"
+
+// ResourceBandWidthAssociate is the impl for huaweicloud_vpc_bandwidth_associate resource, which adds an EIP or an
+// IPv6 port to the shared bandwidth.
+func ResourceBandWidthAssociate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBandWidthAssociateCreate,
+		ReadContext:   resourceBandWidthAssociateRead,
+		DeleteContext: resourceBandWidthAssociateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBandWidthAssociateImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bandwidth_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"eip_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"eip_id", "port_id"},
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"bandwidth_charge_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "bandwidth",
+				ValidateFunc: validation.StringInSlice([]string{"bandwidth", "traffic"}, false),
+			},
+			"bandwidth_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  5,
+			},
+
+			"bandwidth_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildBandWidthAssociatePublicIPInfo(d *schema.ResourceData) bandwidths.PublicIpInfoID {
+	if portId, ok := d.GetOk("port_id"); ok {
+		return bandwidths.PublicIpInfoID{
+			PublicIPID:   portId.(string),
+			PublicIPType: ipv6PortPublicIPType,
+		}
+	}
+	return bandwidths.PublicIpInfoID{
+		PublicIPID: d.Get("eip_id").(string),
+	}
+}
+
+func resourceBandWidthAssociateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v2 client: %s", err)
+	}
+
+	bandwidthId := d.Get("bandwidth_id").(string)
+	publicIPInfo := buildBandWidthAssociatePublicIPInfo(d)
+	insertOpts := bandwidths.BandWidthInsertOpts{
+		PublicipInfo: []bandwidths.PublicIpInfoID{publicIPInfo},
+	}
+	if err = bandwidths.Insert(client, bandwidthId, insertOpts).Err; err != nil {
+		return diag.Errorf("error adding %s to the shared bandwidth (%s): %s", publicIPInfo.PublicIPID, bandwidthId, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", bandwidthId, publicIPInfo.PublicIPID))
+
+	return resourceBandWidthAssociateRead(ctx, d, meta)
+}
+
+func resourceBandWidthAssociateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	bandwidthId, publicId := parseBandWidthAssociateID(d.Id())
+	b, err := bandwidthsv1.Get(client, bandwidthId).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "shared bandwidth")
+	}
+
+	var publicIPInfo *bandwidthsv1.PublicIpinfo
+	for i := range b.PublicipInfo {
+		if b.PublicipInfo[i].PublicipId == publicId {
+			publicIPInfo = &b.PublicipInfo[i]
+			break
+		}
+	}
+	if publicIPInfo == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "shared bandwidth association")
+	}
+
+	address := publicIPInfo.PublicipAddress
+	if publicIPInfo.Publicipv6Address != "" {
+		address = publicIPInfo.Publicipv6Address
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bandwidth_id", b.ID),
+		d.Set("bandwidth_name", b.Name),
+		d.Set("ip_version", publicIPInfo.IPVersion),
+		d.Set("ip_address", address),
+	)
+	if publicIPInfo.PublicipType == ipv6PortPublicIPType {
+		mErr = multierror.Append(mErr, d.Set("port_id", publicId))
+	} else {
+		mErr = multierror.Append(mErr, d.Set("eip_id", publicId))
+	}
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving bandwidth association fields: %s", err)
+	}
+	return nil
+}
+
+func resourceBandWidthAssociateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v2 client: %s", err)
+	}
+
+	bandwidthId := d.Get("bandwidth_id").(string)
+	publicIPInfo := buildBandWidthAssociatePublicIPInfo(d)
+	// The charge mode and size are used to create a dedicated bandwidth for the removed EIP.
+	size := d.Get("bandwidth_size").(int)
+	removeOpts := bandwidths.BandWidthRemoveOpts{
+		ChargeMode:   d.Get("bandwidth_charge_mode").(string),
+		Size:         &size,
+		PublicipInfo: []bandwidths.PublicIpInfoID{publicIPInfo},
+	}
+	if err = bandwidths.Remove(client, bandwidthId, removeOpts).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err,
+			fmt.Sprintf("error removing %s from the shared bandwidth (%s)", publicIPInfo.PublicIPID, bandwidthId))
+	}
+	return nil
+}
+
+func parseBandWidthAssociateID(id string) (bandwidthId, publicId string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+	return parts[0], parts[1]
+}
+
+func resourceBandWidthAssociateImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	bandwidthId, publicId := parseBandWidthAssociateID(d.Id())
+	if publicId == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <bandwidth_id>/<eip_id or port_id>")
+	}
+	return []*schema.ResourceData{d}, d.Set("bandwidth_id", bandwidthId)
+}
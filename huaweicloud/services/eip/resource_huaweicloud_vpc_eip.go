@@ -106,7 +106,7 @@ func ResourceVpcEIPV1() *schema.Resource {
 							Optional:     true,
 							ForceNew:     true,
 							Computed:     true,
-							ValidateFunc: validation.IsIPv4Address,
+							ValidateFunc: utils.ValidateIP,
 							Description:  `The EIP address to be assigned.`,
 						},
 						"ip_version": {
@@ -417,16 +417,22 @@ func eipStatusRefreshFunc(networkingClient *golangsdk.ServiceClient, eipId strin
 	}
 }
 
-func flattenEipPublicIpDetails(publicIp eips.PublicIp) []map[string]interface{} {
+func flattenEipPublicIpDetails(d *schema.ResourceData, publicIp eips.PublicIp) []map[string]interface{} {
 	if reflect.DeepEqual(publicIp, eips.PublicIp{}) {
 		return nil
 	}
 
+	// The IPv6 EIP can be assigned by the IPv6 address, keep the address in the same IP version as the configuration.
+	ipAddress := publicIp.PublicAddress
+	if utils.IsIPv6Address(d.Get("publicip.0.ip_address").(string)) {
+		ipAddress = publicIp.PublicIpv6Address
+	}
+
 	return []map[string]interface{}{
 		{
 			"type":       publicIp.Type,
 			"ip_version": publicIp.IpVersion,
-			"ip_address": publicIp.PublicAddress,
+			"ip_address": ipAddress,
 			"port_id":    publicIp.PortID,
 		},
 	}
@@ -475,7 +481,7 @@ func resourceVpcEipRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("port_id", publicIp.PortID),
 		d.Set("enterprise_project_id", publicIp.EnterpriseProjectID),
 		d.Set("status", NormalizeEipStatus(publicIp.Status)),
-		d.Set("publicip", flattenEipPublicIpDetails(publicIp)),
+		d.Set("publicip", flattenEipPublicIpDetails(d, publicIp)),
 		d.Set("bandwidth", flattenEipBandwidthDetails(publicIp, bandWidth)),
 	)
 
@@ -0,0 +1,285 @@
+// ---------------------------------------------------------------
+// *** AUTO GENERATED CODE ***
+// @Product ELB
+// ---------------------------------------------------------------
+
+package elb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/pagination"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceElbLoadbalances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: resourceElbLoadbalancesRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the ELB load balancer.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the ELB load balancer.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the ELB load balancer.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the VPC where the load balancer resides.`,
+			},
+			"ipv4_subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the IPv4 subnet where the load balancer resides.`,
+			},
+			"ipv6_network_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the subnet where the IPv6 address of the load balancer resides.`,
+			},
+			"ipv4_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the private IPv4 address of the load balancer.`,
+			},
+			"ipv6_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the IPv6 address of the load balancer.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the enterprise project ID of the load balancer.`,
+			},
+			"loadbalancers": {
+				Type:        schema.TypeList,
+				Elem:        loadbalancersLoadbalancersSchema(),
+				Computed:    true,
+				Description: `Load balancer list.`,
+			},
+		},
+	}
+}
+
+func loadbalancersLoadbalancersSchema() *schema.Resource {
+	sc := schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The load balancer ID.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The load balancer name.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The description of load balancer.`,
+			},
+			"availability_zone": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: `The list of AZs where the load balancer is created.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the VPC where the load balancer resides.`,
+			},
+			"ipv4_subnet_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the IPv4 subnet where the load balancer resides.`,
+			},
+			"ipv4_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The private IPv4 address of the load balancer.`,
+			},
+			"ipv4_port_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the port bound to the private IPv4 address of the load balancer.`,
+			},
+			"ipv6_network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the subnet where the IPv6 address of the load balancer resides.`,
+			},
+			"ipv6_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The IPv6 address of the load balancer.`,
+			},
+			"l4_flavor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the Layer-4 flavor.`,
+			},
+			"l7_flavor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the Layer-7 flavor.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The enterprise project ID of the load balancer.`,
+			},
+		},
+	}
+	return &sc
+}
+
+func resourceElbLoadbalancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// listLoadbalancers: Query the List of ELB load balancers
+	var (
+		listLoadbalancersHttpUrl = "v3/{project_id}/elb/loadbalancers"
+		listLoadbalancersProduct = "elb"
+	)
+	listLoadbalancersClient, err := cfg.NewServiceClient(listLoadbalancersProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating Loadbalancers Client: %s", err)
+	}
+
+	listLoadbalancersPath := listLoadbalancersClient.Endpoint + listLoadbalancersHttpUrl
+	listLoadbalancersPath = strings.ReplaceAll(listLoadbalancersPath, "{project_id}",
+		listLoadbalancersClient.ProjectID)
+
+	listLoadbalancersQueryParams := buildListLoadbalancersQueryParams(d)
+	listLoadbalancersPath += listLoadbalancersQueryParams
+
+	listLoadbalancersResp, err := pagination.ListAllItems(
+		listLoadbalancersClient,
+		"marker",
+		listLoadbalancersPath,
+		&pagination.QueryOpts{MarkerField: ""})
+
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Loadbalancers")
+	}
+
+	listLoadbalancersRespJson, err := json.Marshal(listLoadbalancersResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var listLoadbalancersRespBody interface{}
+	err = json.Unmarshal(listLoadbalancersRespJson, &listLoadbalancersRespBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("loadbalancers", flattenListLoadbalancersBodyLoadbalancers(listLoadbalancersRespBody)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenListLoadbalancersBodyLoadbalancers(resp interface{}) []interface{} {
+	if resp == nil {
+		return nil
+	}
+	curJson := utils.PathSearch("loadbalancers", resp, make([]interface{}, 0))
+	if curJson == nil {
+		return nil
+	}
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"id":                    utils.PathSearch("id", v, nil),
+			"name":                  utils.PathSearch("name", v, nil),
+			"description":           utils.PathSearch("description", v, nil),
+			"availability_zone":     utils.PathSearch("availability_zone_list", v, nil),
+			"vpc_id":                utils.PathSearch("vpc_id", v, nil),
+			"ipv4_subnet_id":        utils.PathSearch("vip_subnet_cidr_id", v, nil),
+			"ipv4_address":          utils.PathSearch("vip_address", v, nil),
+			"ipv4_port_id":          utils.PathSearch("vip_port_id", v, nil),
+			"ipv6_network_id":       utils.PathSearch("ipv6_vip_virsubnet_id", v, nil),
+			"ipv6_address":          utils.PathSearch("ipv6_vip_address", v, nil),
+			"l4_flavor_id":          utils.PathSearch("l4_flavor_id", v, nil),
+			"l7_flavor_id":          utils.PathSearch("l7_flavor_id", v, nil),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", v, nil),
+		})
+	}
+	return rst
+}
+
+func buildListLoadbalancersQueryParams(d *schema.ResourceData) string {
+	res := ""
+	if v, ok := d.GetOk("loadbalancer_id"); ok {
+		res = fmt.Sprintf("%s&id=%v", res, v)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		res = fmt.Sprintf("%s&name=%v", res, v)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		res = fmt.Sprintf("%s&description=%v", res, v)
+	}
+	if v, ok := d.GetOk("vpc_id"); ok {
+		res = fmt.Sprintf("%s&vpc_id=%v", res, v)
+	}
+	if v, ok := d.GetOk("ipv4_subnet_id"); ok {
+		res = fmt.Sprintf("%s&vip_subnet_cidr_id=%v", res, v)
+	}
+	if v, ok := d.GetOk("ipv6_network_id"); ok {
+		res = fmt.Sprintf("%s&ipv6_vip_virsubnet_id=%v", res, v)
+	}
+	if v, ok := d.GetOk("ipv4_address"); ok {
+		res = fmt.Sprintf("%s&vip_address=%v", res, v)
+	}
+	if v, ok := d.GetOk("ipv6_address"); ok {
+		res = fmt.Sprintf("%s&ipv6_vip_address=%v", res, v)
+	}
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		res = fmt.Sprintf("%s&enterprise_project_id=%v", res, v)
+	}
+	if res != "" {
+		res = "?" + res[1:]
+	}
+	return res
+}
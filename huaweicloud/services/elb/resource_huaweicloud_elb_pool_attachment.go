@@ -0,0 +1,689 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourcePoolAttachment is used to keep the members of the pool in sync with the backends selected by the source
+// (CCE node pool, AS group or ECS tags).
+func ResourcePoolAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePoolAttachmentCreate,
+		ReadContext:   resourcePoolAttachmentRead,
+		UpdateContext: resourcePoolAttachmentUpdate,
+		DeleteContext: resourcePoolAttachmentDelete,
+
+		CustomizeDiff: resourcePoolAttachmentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the pool is located.`,
+			},
+			"pool_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the pool to which the backends are registered.`,
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  `The port used by the backends to receive requests.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The IPv4 subnet ID of the subnet in which to access the backends.`,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  `The weight of the registered backends.`,
+			},
+			"cce_node_pool": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The ID of the CCE cluster to which the node pool belongs.`,
+						},
+						"node_pool_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The ID of the CCE node pool.`,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"cce_node_pool", "as_group_id", "ecs_tags"},
+				Description:  `The CCE node pool whose nodes are registered as the backends.`,
+			},
+			"as_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The ID of the AS group whose in-service instances are registered as the backends.`,
+			},
+			"ecs_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The tags used to select the ECS instances that are registered as the backends.`,
+			},
+			"deregistration_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  `The time (in seconds) to drain the connections of the backends before deregistering them.`,
+			},
+			"backend_addresses": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The IP addresses of the backends registered by the attachment.`,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the member.`,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The IP address of the member.`,
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the ECS instance corresponding to the member.`,
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The weight of the member.`,
+						},
+						"operating_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The health status of the member.`,
+						},
+					},
+				},
+				Description: `The members registered by the attachment.`,
+			},
+		},
+	}
+}
+
+// attachmentSourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type attachmentSourceGetter interface {
+	Get(key string) interface{}
+}
+
+// queryPoolAttachmentBackends returns the private IPv4 addresses (and the related ECS instance IDs) of the backends
+// selected by the source.
+func queryPoolAttachmentBackends(cfg *config.Config, region string, d attachmentSourceGetter) (map[string]string, error) {
+	if nodePools := d.Get("cce_node_pool").([]interface{}); len(nodePools) > 0 && nodePools[0] != nil {
+		nodePool := nodePools[0].(map[string]interface{})
+		return queryCceNodePoolBackends(cfg, region, nodePool["cluster_id"].(string), nodePool["node_pool_id"].(string))
+	}
+	if groupId := d.Get("as_group_id").(string); groupId != "" {
+		return queryAsGroupBackends(cfg, region, groupId)
+	}
+	return queryEcsTagsBackends(cfg, region, d.Get("ecs_tags").(map[string]interface{}))
+}
+
+func queryCceNodePoolBackends(cfg *config.Config, region, clusterId, nodePoolId string) (map[string]string, error) {
+	client, err := cfg.NewServiceClient("cce", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE client: %s", err)
+	}
+
+	listPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/nodes"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{cluster_id}", clusterId)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error querying nodes of the CCE cluster (%s): %s", clusterId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	nodes := utils.PathSearch("items", respBody, make([]interface{}, 0)).([]interface{})
+	for _, node := range nodes {
+		if utils.PathSearch(`metadata.annotations."kubernetes.io/node-pool.id"`, node, "").(string) != nodePoolId {
+			continue
+		}
+		// Only the nodes that are running can receive the requests.
+		if utils.PathSearch("status.phase", node, "").(string) != "Active" {
+			continue
+		}
+		if address := utils.PathSearch("status.privateIP", node, "").(string); address != "" {
+			result[address] = utils.PathSearch("status.serverId", node, "").(string)
+		}
+	}
+	return result, nil
+}
+
+func queryAsGroupBackends(cfg *config.Config, region, groupId string) (map[string]string, error) {
+	client, err := cfg.NewServiceClient("autoscaling", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AS client: %s", err)
+	}
+
+	listPath := client.Endpoint + "autoscaling-api/v1/{project_id}/scaling_group_instance/{scaling_group_id}/list"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{scaling_group_id}", groupId)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	instanceIds := make([]string, 0)
+	for startNumber := 0; ; {
+		resp, err := client.Request("GET", fmt.Sprintf("%s?limit=100&start_number=%d", listPath, startNumber), &listOpt)
+		if err != nil {
+			return nil, fmt.Errorf("error querying instances of the AS group (%s): %s", groupId, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		instances := utils.PathSearch("scaling_group_instances", respBody, make([]interface{}, 0)).([]interface{})
+		for _, instance := range instances {
+			if utils.PathSearch("life_cycle_state", instance, "").(string) == "INSERVICE" {
+				instanceIds = append(instanceIds, utils.PathSearch("instance_id", instance, "").(string))
+			}
+		}
+		startNumber += len(instances)
+		if len(instances) < 100 {
+			break
+		}
+	}
+	return queryEcsInstanceAddresses(cfg, region, instanceIds)
+}
+
+func queryEcsTagsBackends(cfg *config.Config, region string, tags map[string]interface{}) (map[string]string, error) {
+	client, err := cfg.NewServiceClient("ecs", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+
+	tagFilters := make([]map[string]interface{}, 0, len(tags))
+	for k, v := range tags {
+		tagFilters = append(tagFilters, map[string]interface{}{
+			"key":    k,
+			"values": []interface{}{v},
+		})
+	}
+
+	filterPath := client.Endpoint + "v1/{project_id}/cloudservers/resource_instances/action"
+	filterPath = strings.ReplaceAll(filterPath, "{project_id}", client.ProjectID)
+	instanceIds := make([]string, 0)
+	for offset := 0; ; {
+		filterOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"action": "filter",
+				"tags":   tagFilters,
+				"limit":  "1000",
+				"offset": fmt.Sprint(offset),
+			},
+			OkCodes: []int{200},
+		}
+		resp, err := client.Request("POST", filterPath, &filterOpt)
+		if err != nil {
+			return nil, fmt.Errorf("error filtering ECS instances by tags: %s", err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		resources := utils.PathSearch("resources", respBody, make([]interface{}, 0)).([]interface{})
+		for _, res := range resources {
+			instanceIds = append(instanceIds, utils.PathSearch("resource_id", res, "").(string))
+		}
+		offset += len(resources)
+		if len(resources) < 1000 {
+			break
+		}
+	}
+	return queryEcsInstanceAddresses(cfg, region, instanceIds)
+}
+
+// queryEcsInstanceAddresses returns the primary private IPv4 address of each running ECS instance.
+func queryEcsInstanceAddresses(cfg *config.Config, region string, instanceIds []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(instanceIds) < 1 {
+		return result, nil
+	}
+
+	client, err := cfg.NewServiceClient("ecs", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	for _, instanceId := range instanceIds {
+		getPath := client.Endpoint + "v1/{project_id}/cloudservers/{server_id}"
+		getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+		getPath = strings.ReplaceAll(getPath, "{server_id}", instanceId)
+		resp, err := client.Request("GET", getPath, &getOpt)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[WARN] the ECS instance (%s) has been deleted, skip it", instanceId)
+				continue
+			}
+			return nil, fmt.Errorf("error querying ECS instance (%s): %s", instanceId, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if utils.PathSearch("server.status", respBody, "").(string) != "ACTIVE" {
+			continue
+		}
+		expression := `server.addresses.*[] | [?"OS-EXT-IPS:type"=='fixed' && version=='4'] | [0].addr`
+		if address := utils.PathSearch(expression, respBody, "").(string); address != "" {
+			result[address] = instanceId
+		}
+	}
+	return result, nil
+}
+
+// QueryPoolMembers returns all members of the pool which are listening on the specified port, the key is the address.
+func QueryPoolMembers(client *golangsdk.ServiceClient, poolId string, protocolPort int) (map[string]interface{}, error) {
+	listPath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members?limit=2000"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{pool_id}", poolId)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make(map[string]interface{})
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath = fmt.Sprintf("%s&marker=%s", listPath, marker)
+		}
+		resp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		members := utils.PathSearch("members", respBody, make([]interface{}, 0)).([]interface{})
+		for _, member := range members {
+			if int(utils.PathSearch("protocol_port", member, float64(0)).(float64)) == protocolPort {
+				result[utils.PathSearch("address", member, "").(string)] = member
+			}
+		}
+
+		marker = utils.PathSearch("page_info.next_marker", respBody, "").(string)
+		if marker == "" || len(members) < 1 {
+			break
+		}
+	}
+	return result, nil
+}
+
+func registerPoolMembers(client *golangsdk.ServiceClient, d *schema.ResourceData, addresses []string) error {
+	if len(addresses) < 1 {
+		return nil
+	}
+
+	members := make([]map[string]interface{}, len(addresses))
+	for i, address := range addresses {
+		members[i] = utils.RemoveNil(map[string]interface{}{
+			"address":        address,
+			"protocol_port":  d.Get("protocol_port"),
+			"weight":         d.Get("weight"),
+			"subnet_cidr_id": utils.ValueIngoreEmpty(d.Get("subnet_id")),
+		})
+	}
+
+	poolId := d.Get("pool_id").(string)
+	addPath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members/batch-add"
+	addPath = strings.ReplaceAll(addPath, "{project_id}", client.ProjectID)
+	addPath = strings.ReplaceAll(addPath, "{pool_id}", poolId)
+	addOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"members": members,
+		},
+		OkCodes: []int{200, 201},
+	}
+	_, err := client.Request("POST", addPath, &addOpt)
+	if err != nil {
+		return fmt.Errorf("error registering backends (%v) to the pool (%s): %s", addresses, poolId, err)
+	}
+	return nil
+}
+
+func updatePoolMemberWeight(client *golangsdk.ServiceClient, poolId, memberId string, weight int) error {
+	updatePath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members/{member_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{pool_id}", poolId)
+	updatePath = strings.ReplaceAll(updatePath, "{member_id}", memberId)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"member": map[string]interface{}{
+				"weight": weight,
+			},
+		},
+		OkCodes: []int{200},
+	}
+	_, err := client.Request("PUT", updatePath, &updateOpt)
+	return err
+}
+
+// deregisterPoolMembers stops forwarding new requests to the members (by setting their weight to 0) and waits for the
+// deregistration delay before removing them from the pool, so that the in-flight connections can be drained.
+func deregisterPoolMembers(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	members []interface{}) error {
+	if len(members) < 1 {
+		return nil
+	}
+
+	poolId := d.Get("pool_id").(string)
+	memberIds := make([]map[string]interface{}, len(members))
+	for i, member := range members {
+		memberId := utils.PathSearch("id", member, "").(string)
+		memberIds[i] = map[string]interface{}{"id": memberId}
+	}
+
+	if delay := d.Get("deregistration_delay").(int); delay > 0 {
+		for _, memberId := range memberIds {
+			err := updatePoolMemberWeight(client, poolId, memberId["id"].(string), 0)
+			if err != nil {
+				return fmt.Errorf("error draining member (%s) of the pool (%s): %s", memberId["id"], poolId, err)
+			}
+		}
+		log.Printf("[DEBUG] waiting %d seconds for draining the connections of members: %v", delay, memberIds)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(delay) * time.Second):
+		}
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/elb/pools/{pool_id}/members/batch-delete"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{pool_id}", poolId)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"members": memberIds,
+		},
+		OkCodes: []int{200, 201, 204},
+	}
+	_, err := client.Request("POST", deletePath, &deleteOpt)
+	if err != nil {
+		return fmt.Errorf("error deregistering members (%v) from the pool (%s): %s", memberIds, poolId, err)
+	}
+	return nil
+}
+
+// reconcilePoolMembers registers the missing backends and deregisters the backends which are no longer selected by the
+// source.
+func reconcilePoolMembers(ctx context.Context, cfg *config.Config, d *schema.ResourceData) error {
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("elb", region)
+	if err != nil {
+		return fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	desired, err := queryPoolAttachmentBackends(cfg, region, d)
+	if err != nil {
+		return err
+	}
+	actual, err := QueryPoolMembers(client, d.Get("pool_id").(string), d.Get("protocol_port").(int))
+	if err != nil {
+		return fmt.Errorf("error querying members of the pool: %s", err)
+	}
+
+	var (
+		tracked   = d.Get("backend_addresses").(*schema.Set)
+		toAdd     = make([]string, 0)
+		toRemove  = make([]interface{}, 0)
+		toReweigh = make([]interface{}, 0)
+		weight    = d.Get("weight").(int)
+	)
+	for address := range desired {
+		member, ok := actual[address]
+		if !ok {
+			toAdd = append(toAdd, address)
+			continue
+		}
+		if int(utils.PathSearch("weight", member, float64(0)).(float64)) != weight {
+			toReweigh = append(toReweigh, member)
+		}
+	}
+	// Only the members registered by this attachment are removed.
+	for _, address := range tracked.List() {
+		if _, ok := desired[address.(string)]; ok {
+			continue
+		}
+		if member, ok := actual[address.(string)]; ok {
+			toRemove = append(toRemove, member)
+		}
+	}
+	sort.Strings(toAdd)
+
+	log.Printf("[DEBUG] reconciling the pool attachment (%s), register: %v, deregister: %v", d.Id(), toAdd, toRemove)
+	if err = deregisterPoolMembers(ctx, client, d, toRemove); err != nil {
+		return err
+	}
+	if err = registerPoolMembers(client, d, toAdd); err != nil {
+		return err
+	}
+	for _, member := range toReweigh {
+		memberId := utils.PathSearch("id", member, "").(string)
+		if err = updatePoolMemberWeight(client, d.Get("pool_id").(string), memberId, weight); err != nil {
+			return fmt.Errorf("error updating the weight of the member (%s): %s", memberId, err)
+		}
+	}
+
+	addresses := make([]string, 0, len(desired))
+	for address := range desired {
+		addresses = append(addresses, address)
+	}
+	return d.Set("backend_addresses", addresses)
+}
+
+func resourcePoolAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if err := reconcilePoolMembers(ctx, cfg, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%d", d.Get("pool_id").(string), d.Get("protocol_port").(int)))
+
+	return resourcePoolAttachmentRead(ctx, d, meta)
+}
+
+func flattenPoolAttachmentMembers(members []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(members))
+	for i, member := range members {
+		result[i] = map[string]interface{}{
+			"id":               utils.PathSearch("id", member, nil),
+			"address":          utils.PathSearch("address", member, nil),
+			"instance_id":      utils.PathSearch("instance_id", member, nil),
+			"weight":           utils.PathSearch("weight", member, nil),
+			"operating_status": utils.PathSearch("operating_status", member, nil),
+		}
+	}
+	return result
+}
+
+func resourcePoolAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("elb", region)
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	poolId := d.Get("pool_id").(string)
+	actual, err := QueryPoolMembers(client, poolId, d.Get("protocol_port").(int))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ELB pool attachment")
+	}
+
+	// Only the registered members which are still in the pool are kept, the backends removed outside of Terraform
+	// are reported as drift and will be registered again.
+	var (
+		addresses = make([]string, 0)
+		members   = make([]interface{}, 0)
+	)
+	for _, address := range d.Get("backend_addresses").(*schema.Set).List() {
+		member, ok := actual[address.(string)]
+		if !ok {
+			log.Printf("[WARN] the backend (%s) has been removed from the pool (%s) outside of Terraform", address, poolId)
+			continue
+		}
+		addresses = append(addresses, address.(string))
+		members = append(members, member)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("backend_addresses", addresses),
+		d.Set("members", flattenPoolAttachmentMembers(members)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving ELB pool attachment (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourcePoolAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if err := reconcilePoolMembers(ctx, cfg, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePoolAttachmentRead(ctx, d, meta)
+}
+
+func resourcePoolAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("elb", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	actual, err := QueryPoolMembers(client, d.Get("pool_id").(string), d.Get("protocol_port").(int))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error querying members of the pool")
+	}
+	members := make([]interface{}, 0)
+	for _, address := range d.Get("backend_addresses").(*schema.Set).List() {
+		if member, ok := actual[address.(string)]; ok {
+			members = append(members, member)
+		}
+	}
+	if err = deregisterPoolMembers(ctx, client, d, members); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourcePoolAttachmentCustomizeDiff compares the backends selected by the source with the registered backends, the
+// added or removed backends are reported as changes of the backend_addresses.
+func resourcePoolAttachmentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return d.SetNewComputed("backend_addresses")
+	}
+	if !d.NewValueKnown("cce_node_pool") || !d.NewValueKnown("as_group_id") || !d.NewValueKnown("ecs_tags") {
+		return d.SetNewComputed("backend_addresses")
+	}
+	// The backends are reconciled again during the update.
+	if d.HasChange("weight") {
+		if err := d.SetNewComputed("backend_addresses"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("members")
+	}
+
+	cfg := meta.(*config.Config)
+	region := d.Get("region").(string)
+	if region == "" {
+		region = cfg.Region
+	}
+	desired, err := queryPoolAttachmentBackends(cfg, region, d)
+	if err != nil {
+		return err
+	}
+
+	tracked := d.Get("backend_addresses").(*schema.Set)
+	addresses := make([]interface{}, 0, len(desired))
+	for address := range desired {
+		addresses = append(addresses, address)
+	}
+	if tracked.Equal(schema.NewSet(schema.HashString, addresses)) {
+		return nil
+	}
+	log.Printf("[DEBUG] the backends of the pool attachment (%s) have drifted, registered: %v, expected: %v",
+		d.Id(), tracked.List(), addresses)
+	if err = d.SetNew("backend_addresses", addresses); err != nil {
+		return err
+	}
+	return d.SetNewComputed("members")
+}
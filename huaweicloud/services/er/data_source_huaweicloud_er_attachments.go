@@ -0,0 +1,253 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the ER instance and attachments are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the ER instance to which the attachments belong.`,
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The attachment ID used to query specified attachment.`,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "vpn", "vgw", "peering", "can", "enc", "cfw",
+				}, false),
+				Description: `The attachment type used to filter the attachments.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The status used to filter the attachments.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the attachments.`,
+			},
+			"tags": common.TagsSchema(),
+			// Attributes
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The attachment ID.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the attachment.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the attachment.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the attachment.`,
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the resource associated with the attachment.`,
+						},
+						"resource_project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The project ID to which the resource associated with the attachment belongs.`,
+						},
+						"route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the route table associated with the attachment.`,
+						},
+						"associated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the attachment is associated with a route table.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The current status of the attachment.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The creation time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The latest update time.`,
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The tags configuration of the attachment.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildAttachmentsQueryParams(d *schema.ResourceData) string {
+	res := "?limit=100"
+	if v, ok := d.GetOk("attachment_id"); ok {
+		res = fmt.Sprintf("%s&id=%v", res, v)
+	}
+	if v, ok := d.GetOk("type"); ok {
+		res = fmt.Sprintf("%s&resource_type=%v", res, v)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		res = fmt.Sprintf("%s&state=%v", res, v)
+	}
+	return res
+}
+
+func queryAttachments(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]interface{}, error) {
+	listPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/attachments"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{er_id}", d.Get("instance_id").(string))
+	listPath += buildAttachmentsQueryParams(d)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make([]interface{}, 0)
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath = fmt.Sprintf("%s&marker=%s", listPath, marker)
+		}
+		resp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		attachments := utils.PathSearch("attachments", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, attachments...)
+
+		marker = utils.PathSearch("page_info.next_marker", respBody, "").(string)
+		if marker == "" || len(attachments) < 1 {
+			break
+		}
+	}
+	return result, nil
+}
+
+func flattenAttachments(d *schema.ResourceData, all []interface{}) []map[string]interface{} {
+	var (
+		name      = d.Get("name").(string)
+		tagFilter = d.Get("tags").(map[string]interface{})
+		result    = make([]map[string]interface{}, 0, len(all))
+	)
+
+	for _, attachment := range all {
+		if name != "" && utils.PathSearch("name", attachment, "").(string) != name {
+			continue
+		}
+		tagmap := utils.FlattenTagsToMap(utils.PathSearch("tags", attachment, nil))
+		if !utils.HasMapContains(convertAttachmentTags(tagmap), tagFilter) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                  utils.PathSearch("id", attachment, nil),
+			"name":                utils.PathSearch("name", attachment, nil),
+			"description":         utils.PathSearch("description", attachment, nil),
+			"type":                utils.PathSearch("resource_type", attachment, nil),
+			"resource_id":         utils.PathSearch("resource_id", attachment, nil),
+			"resource_project_id": utils.PathSearch("resource_project_id", attachment, nil),
+			"route_table_id":      utils.PathSearch("route_table_id", attachment, nil),
+			"associated":          utils.PathSearch("associated", attachment, false),
+			"status":              utils.PathSearch("state", attachment, nil),
+			"created_at":          utils.PathSearch("created_at", attachment, nil),
+			"updated_at":          utils.PathSearch("updated_at", attachment, nil),
+			"tags":                tagmap,
+		})
+	}
+	return result
+}
+
+func convertAttachmentTags(tagmap map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(tagmap))
+	for k, v := range tagmap {
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
+
+func dataSourceAttachmentsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("er", region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	attachments, err := queryAttachments(client, d)
+	if err != nil {
+		return diag.Errorf("error retrieving attachments: %s", err)
+	}
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("attachments", flattenAttachments(d, attachments)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving attachment list field: %s", mErr)
+	}
+	return nil
+}
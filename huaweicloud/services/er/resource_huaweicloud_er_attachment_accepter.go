@@ -0,0 +1,203 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceAttachmentAccepter is used to accept (or reject) the attachments which are created by other accounts
+// through a shared ER instance.
+func ResourceAttachmentAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAttachmentAccepterCreate,
+		ReadContext:   resourceAttachmentAccepterRead,
+		DeleteContext: resourceAttachmentAccepterDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER instance and the attachment are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the ER instance to which the attachment belongs.`,
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the attachment to be accepted or rejected.`,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"accept", "reject"}, false),
+				Description:  `The action type of the attachment.`,
+			},
+			"attachment_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the attachment.`,
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the resource associated with the attachment.`,
+			},
+			"resource_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The project ID to which the resource associated with the attachment belongs.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the attachment.`,
+			},
+		},
+	}
+}
+
+func getAttachment(client *golangsdk.ServiceClient, instanceId, attachmentId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{er_id}", instanceId)
+	getPath = strings.ReplaceAll(getPath, "{attachment_id}", attachmentId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func attachmentStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, attachmentId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getAttachment(client, instanceId, attachmentId)
+		if err != nil {
+			return nil, "", err
+		}
+		status := utils.PathSearch("attachment.state", respBody, "").(string)
+		log.Printf("[DEBUG] The status of the attachment (%s) is: %s", attachmentId, status)
+
+		if status == "failed" {
+			return respBody, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return respBody, "COMPLETED", nil
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	var (
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Get("attachment_id").(string)
+		action       = d.Get("action").(string)
+	)
+	actionPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/attachments/{attachment_id}/{action}"
+	actionPath = strings.ReplaceAll(actionPath, "{project_id}", client.ProjectID)
+	actionPath = strings.ReplaceAll(actionPath, "{er_id}", instanceId)
+	actionPath = strings.ReplaceAll(actionPath, "{attachment_id}", attachmentId)
+	actionPath = strings.ReplaceAll(actionPath, "{action}", action)
+	actionOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202},
+	}
+	_, err = client.Request("POST", actionPath, &actionOpt)
+	if err != nil {
+		return diag.Errorf("error trying to %s the attachment (%s): %s", action, attachmentId, err)
+	}
+	d.SetId(attachmentId)
+
+	targets := []string{"available"}
+	if action == "reject" {
+		targets = []string{"rejected"}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      attachmentStatusRefreshFunc(client, instanceId, attachmentId, targets),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the attachment (%s) to be %sed: %s", attachmentId, action, err)
+	}
+	return resourceAttachmentAccepterRead(ctx, d, meta)
+}
+
+func resourceAttachmentAccepterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("er", region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	respBody, err := getAttachment(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("attachment_type", utils.PathSearch("attachment.resource_type", respBody, nil)),
+		d.Set("resource_id", utils.PathSearch("attachment.resource_id", respBody, nil)),
+		d.Set("resource_project_id", utils.PathSearch("attachment.resource_project_id", respBody, nil)),
+		d.Set("status", utils.PathSearch("attachment.state", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving attachment accepter (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceAttachmentAccepterDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the attachment accepter is not supported. The accepter is only removed from the state, " +
+		"the attachment remains in its current status and can be deleted by its owner."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,371 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceFlowLog() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFlowLogCreate,
+		ReadContext:   resourceFlowLogRead,
+		UpdateContext: resourceFlowLogUpdate,
+		DeleteContext: resourceFlowLogDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFlowLogImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER instance and flow log are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the ER instance to which the flow log belongs.`,
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the attachment whose traffic is recorded by the flow log.`,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "attachment",
+				ValidateFunc: validation.StringInSlice([]string{"attachment"}, false),
+				Description:  `The type of the resource whose traffic is recorded by the flow log.`,
+			},
+			"log_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the LTS log group.`,
+			},
+			"log_stream_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the LTS log stream.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w.-]*$"), "The name only english and "+
+						"chinese letters, digits, underscore (_), hyphens (-) and dots (.) are allowed."),
+				),
+				Description: `The name of the flow log.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the flow log.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Whether to enable the flow log.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the flow log.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the flow log.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the flow log.`,
+			},
+		},
+	}
+}
+
+func buildFlowLogCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	flowLogParams := map[string]interface{}{
+		"log_store_type": "LTS",
+		"log_group_id":   d.Get("log_group_id"),
+		"log_stream_id":  d.Get("log_stream_id"),
+		"resource_type":  d.Get("resource_type"),
+		"resource_id":    d.Get("resource_id"),
+		"name":           d.Get("name"),
+		"description":    utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	return map[string]interface{}{
+		"flow_log": utils.RemoveNil(flowLogParams),
+	}
+}
+
+func resourceFlowLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/flow-logs"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createPath = strings.ReplaceAll(createPath, "{er_id}", instanceId)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildFlowLogCreateBodyParams(d),
+		OkCodes:          []int{202},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating flow log: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	flowLogId := utils.PathSearch("flow_log.id", respBody, "").(string)
+	if flowLogId == "" {
+		return diag.Errorf("unable to find the flow log ID from the API response")
+	}
+	d.SetId(flowLogId)
+
+	if err = waitForFlowLogStatusCompleted(ctx, client, d, schema.TimeoutCreate); err != nil {
+		return diag.Errorf("error waiting for the flow log (%s) creation to complete: %s", d.Id(), err)
+	}
+
+	// The flow log is enabled by default after creation.
+	if !d.Get("enabled").(bool) {
+		if err = switchFlowLogEnabled(ctx, client, d, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceFlowLogRead(ctx, d, meta)
+}
+
+// GetFlowLog is a method to query the flow log details using its ER instance ID and flow log ID.
+func GetFlowLog(client *golangsdk.ServiceClient, instanceId, flowLogId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/flow-logs/{flow_log_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{er_id}", instanceId)
+	getPath = strings.ReplaceAll(getPath, "{flow_log_id}", flowLogId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func flowLogStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, flowLogId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := GetFlowLog(client, instanceId, flowLogId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+		status := utils.PathSearch("flow_log.state", respBody, "").(string)
+		log.Printf("[DEBUG] The status of the flow log (%s) is: %s", flowLogId, status)
+
+		if status == "failed" {
+			return respBody, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return respBody, "COMPLETED", nil
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForFlowLogStatusCompleted(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeoutKey string) error {
+	var targets []string
+	if timeoutKey != schema.TimeoutDelete {
+		targets = []string{"available"}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      flowLogStatusRefreshFunc(client, d.Get("instance_id").(string), d.Id(), targets),
+		Timeout:      d.Timeout(timeoutKey),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func switchFlowLogEnabled(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	actionPath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/flow-logs/{flow_log_id}/{action}"
+	actionPath = strings.ReplaceAll(actionPath, "{project_id}", client.ProjectID)
+	actionPath = strings.ReplaceAll(actionPath, "{er_id}", d.Get("instance_id").(string))
+	actionPath = strings.ReplaceAll(actionPath, "{flow_log_id}", d.Id())
+	actionPath = strings.ReplaceAll(actionPath, "{action}", action)
+	actionOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202},
+	}
+	_, err := client.Request("POST", actionPath, &actionOpt)
+	if err != nil {
+		return fmt.Errorf("error trying to %s the flow log (%s): %s", action, d.Id(), err)
+	}
+
+	timeoutKey := schema.TimeoutUpdate
+	if d.IsNewResource() {
+		timeoutKey = schema.TimeoutCreate
+	}
+	if err = waitForFlowLogStatusCompleted(ctx, client, d, timeoutKey); err != nil {
+		return fmt.Errorf("error waiting for the flow log (%s) to %s: %s", d.Id(), action, err)
+	}
+	return nil
+}
+
+func resourceFlowLogRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("er", region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	respBody, err := GetFlowLog(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER flow log")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("resource_id", utils.PathSearch("flow_log.resource_id", respBody, nil)),
+		d.Set("resource_type", utils.PathSearch("flow_log.resource_type", respBody, nil)),
+		d.Set("log_group_id", utils.PathSearch("flow_log.log_group_id", respBody, nil)),
+		d.Set("log_stream_id", utils.PathSearch("flow_log.log_stream_id", respBody, nil)),
+		d.Set("name", utils.PathSearch("flow_log.name", respBody, nil)),
+		d.Set("description", utils.PathSearch("flow_log.description", respBody, nil)),
+		d.Set("enabled", utils.PathSearch("flow_log.enabled", respBody, false)),
+		d.Set("status", utils.PathSearch("flow_log.state", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("flow_log.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("flow_log.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving flow log (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceFlowLogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updatePath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/flow-logs/{flow_log_id}"
+		updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+		updatePath = strings.ReplaceAll(updatePath, "{er_id}", d.Get("instance_id").(string))
+		updatePath = strings.ReplaceAll(updatePath, "{flow_log_id}", d.Id())
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"flow_log": map[string]interface{}{
+					"name":        d.Get("name"),
+					"description": d.Get("description"),
+				},
+			},
+			OkCodes: []int{200},
+		}
+		_, err = client.Request("PUT", updatePath, &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating flow log (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err = switchFlowLogEnabled(ctx, client, d, d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceFlowLogRead(ctx, d, meta)
+}
+
+func resourceFlowLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/flow-logs/{flow_log_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{er_id}", d.Get("instance_id").(string))
+	deletePath = strings.ReplaceAll(deletePath, "{flow_log_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202, 204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting flow log")
+	}
+
+	if err = waitForFlowLogStatusCompleted(ctx, client, d, schema.TimeoutDelete); err != nil {
+		return diag.Errorf("error waiting for the flow log (%s) deletion to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceFlowLogImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<instance_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
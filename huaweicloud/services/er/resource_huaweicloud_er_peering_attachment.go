@@ -0,0 +1,320 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourcePeeringAttachment is used to connect two ER instances (in different regions or accounts) by a peering
+// attachment. The attachment created to the ER instance of other account needs to be accepted by the peer account
+// through huaweicloud_er_attachment_accepter.
+func ResourcePeeringAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePeeringAttachmentCreate,
+		UpdateContext: resourcePeeringAttachmentUpdate,
+		ReadContext:   resourcePeeringAttachmentRead,
+		DeleteContext: resourcePeeringAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVpcAttachmentImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER instance and the peering attachment are located.`,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the ER instance to which the peering attachment belongs.`,
+			},
+			"peer_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the peer ER instance.`,
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the peer ER instance is located.`,
+			},
+			"peer_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The project ID to which the peer ER instance belongs.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile("^[\u4e00-\u9fa5\\w.-]*$"), "The name only english and "+
+						"chinese letters, digits, underscore (_), hyphens (-) and dots (.) are allowed."),
+				),
+				Description: `The name of the peering attachment.`,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"The angle brackets (< and >) are not allowed."),
+				),
+				Description: `The description of the peering attachment.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			// Attributes
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the peering attachment.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time.`,
+			},
+		},
+	}
+}
+
+func buildPeeringAttachmentPath(client *golangsdk.ServiceClient, instanceId, attachmentId string) string {
+	path := client.Endpoint + "v3/{project_id}/enterprise-router/{er_id}/peering-attachments"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{er_id}", instanceId)
+	if attachmentId != "" {
+		path += "/" + attachmentId
+	}
+	return path
+}
+
+func buildPeeringAttachmentCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"peering_attachment": utils.RemoveNil(map[string]interface{}{
+			"name":            d.Get("name"),
+			"description":     utils.ValueIngoreEmpty(d.Get("description")),
+			"peer_router_id":  d.Get("peer_instance_id"),
+			"peer_region_id":  utils.ValueIngoreEmpty(d.Get("peer_region")),
+			"peer_project_id": utils.ValueIngoreEmpty(d.Get("peer_project_id")),
+			"tags":            utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+		}),
+	}
+}
+
+func getPeeringAttachment(client *golangsdk.ServiceClient, instanceId, attachmentId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", buildPeeringAttachmentPath(client, instanceId, attachmentId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func peeringAttachmentStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, attachmentId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getPeeringAttachment(client, instanceId, attachmentId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "deleted", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+		status := utils.PathSearch("peering_attachment.state", respBody, "").(string)
+		log.Printf("[DEBUG] The status of the peering attachment (%s) is: %s", attachmentId, status)
+
+		if status == "failed" {
+			return respBody, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return respBody, "COMPLETED", nil
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForPeeringAttachmentStatus(ctx context.Context, client *golangsdk.ServiceClient, instanceId,
+	attachmentId string, targets []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      peeringAttachmentStatusRefreshFunc(client, instanceId, attachmentId, targets),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourcePeeringAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildPeeringAttachmentCreateBodyParams(d),
+		OkCodes:          []int{200, 201, 202},
+	}
+	resp, err := client.Request("POST", buildPeeringAttachmentPath(client, instanceId, ""), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating peering attachment: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attachmentId := utils.PathSearch("peering_attachment.id", respBody, "").(string)
+	if attachmentId == "" {
+		return diag.Errorf("unable to find the peering attachment ID from the API response")
+	}
+	d.SetId(attachmentId)
+
+	// The attachment to the ER instance of other account is waiting for the acceptance of the peer account.
+	err = waitForPeeringAttachmentStatus(ctx, client, instanceId, attachmentId,
+		[]string{"available", "pending_acceptance"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the peering attachment (%s) to be created: %s", attachmentId, err)
+	}
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("er", region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	respBody, err := getPeeringAttachment(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER peering attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("peer_instance_id", utils.PathSearch("peering_attachment.peer_router_id", respBody, nil)),
+		d.Set("peer_region", utils.PathSearch("peering_attachment.peer_region_id", respBody, nil)),
+		d.Set("peer_project_id", utils.PathSearch("peering_attachment.peer_project_id", respBody, nil)),
+		d.Set("name", utils.PathSearch("peering_attachment.name", respBody, nil)),
+		d.Set("description", utils.PathSearch("peering_attachment.description", respBody, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("peering_attachment.tags", respBody, nil))),
+		d.Set("status", utils.PathSearch("peering_attachment.state", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("peering_attachment.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("peering_attachment.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving peering attachment (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourcePeeringAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	var (
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+	)
+	if d.HasChanges("name", "description") {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"peering_attachment": map[string]interface{}{
+					"name":        d.Get("name"),
+					"description": d.Get("description"),
+				},
+			},
+			OkCodes: []int{200},
+		}
+		_, err = client.Request("PUT", buildPeeringAttachmentPath(client, instanceId, attachmentId), &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating peering attachment (%s): %s", attachmentId, err)
+		}
+
+		err = waitForPeeringAttachmentStatus(ctx, client, instanceId, attachmentId,
+			[]string{"available", "pending_acceptance"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the peering attachment (%s) to be updated: %s", attachmentId, err)
+		}
+	}
+
+	return resourcePeeringAttachmentRead(ctx, d, meta)
+}
+
+func resourcePeeringAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	var (
+		instanceId   = d.Get("instance_id").(string)
+		attachmentId = d.Id()
+	)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202, 204},
+	}
+	_, err = client.Request("DELETE", buildPeeringAttachmentPath(client, instanceId, attachmentId), &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting peering attachment")
+	}
+
+	err = waitForPeeringAttachmentStatus(ctx, client, instanceId, attachmentId, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the peering attachment (%s) to be deleted: %s", attachmentId, err)
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package er
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStaticRouteCreate,
+		ReadContext:   resourceStaticRouteRead,
+		UpdateContext: resourceStaticRouteUpdate,
+		DeleteContext: resourceStaticRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStaticRouteImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the ER route table and static route are located.`,
+			},
+			"route_table_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the route table to which the static route belongs.`,
+			},
+			"destination": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  `The destination address (CIDR) of the static route.`,
+			},
+			"attachment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"is_blackhole"},
+				Description:  `The ID of the attachment used as the next hop of the static route.`,
+			},
+			"is_blackhole": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether the static route is a black hole route.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the static route.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the static route.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the static route.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the static route.`,
+			},
+		},
+	}
+}
+
+func buildStaticRouteBodyParams(d *schema.ResourceData, isCreate bool) map[string]interface{} {
+	routeParams := map[string]interface{}{
+		"attachment_id": utils.ValueIngoreEmpty(d.Get("attachment_id")),
+		"is_blackhole":  d.Get("is_blackhole"),
+	}
+	if isCreate {
+		routeParams["destination"] = d.Get("destination")
+	}
+	return map[string]interface{}{
+		"route": utils.RemoveNil(routeParams),
+	}
+}
+
+func resourceStaticRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	createPath := client.Endpoint + "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createPath = strings.ReplaceAll(createPath, "{route_table_id}", routeTableId)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildStaticRouteBodyParams(d, true),
+		OkCodes:          []int{202},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating static route: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	routeId := utils.PathSearch("route.id", respBody, "").(string)
+	if routeId == "" {
+		return diag.Errorf("unable to find the static route ID from the API response")
+	}
+	d.SetId(routeId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, d.Id(), []string{"available"}),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) creation to complete: %s", d.Id(), err)
+	}
+	return resourceStaticRouteRead(ctx, d, meta)
+}
+
+// GetStaticRoute is a method to query the static route details using its route table ID and route ID.
+func GetStaticRoute(client *golangsdk.ServiceClient, routeTableId, routeId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes/{route_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{route_table_id}", routeTableId)
+	getPath = strings.ReplaceAll(getPath, "{route_id}", routeId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func staticRouteStatusRefreshFunc(client *golangsdk.ServiceClient, routeTableId, routeId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := GetStaticRoute(client, routeTableId, routeId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "", "COMPLETED", nil
+			}
+			return nil, "", err
+		}
+		status := utils.PathSearch("route.state", respBody, "").(string)
+		log.Printf("[DEBUG] The status of the static route (%s) is: %s", routeId, status)
+
+		if status == "failed" {
+			return respBody, "", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return respBody, "COMPLETED", nil
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func resourceStaticRouteRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("er", region)
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	respBody, err := GetStaticRoute(client, d.Get("route_table_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "ER static route")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("route_table_id", utils.PathSearch("route.route_table_id", respBody, nil)),
+		d.Set("destination", utils.PathSearch("route.destination", respBody, nil)),
+		d.Set("attachment_id", utils.PathSearch("route.attachments[0].attachment_id", respBody, nil)),
+		d.Set("is_blackhole", utils.PathSearch("route.is_blackhole", respBody, false)),
+		d.Set("type", utils.PathSearch("route.type", respBody, nil)),
+		d.Set("status", utils.PathSearch("route.state", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("route.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("route.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving static route (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	updatePath := client.Endpoint + "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes/{route_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{route_table_id}", routeTableId)
+	updatePath = strings.ReplaceAll(updatePath, "{route_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildStaticRouteBodyParams(d, false),
+		OkCodes:          []int{200},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating static route (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, d.Id(), []string{"available"}),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) update to complete: %s", d.Id(), err)
+	}
+	return resourceStaticRouteRead(ctx, d, meta)
+}
+
+func resourceStaticRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("er", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ER v3 client: %s", err)
+	}
+
+	routeTableId := d.Get("route_table_id").(string)
+	deletePath := client.Endpoint + "v3/{project_id}/enterprise-router/route-tables/{route_table_id}/static-routes/{route_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{route_table_id}", routeTableId)
+	deletePath = strings.ReplaceAll(deletePath, "{route_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202, 204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting static route")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      staticRouteStatusRefreshFunc(client, routeTableId, d.Id(), nil),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the static route (%s) deletion to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceStaticRouteImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<route_table_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("route_table_id", parts[0])
+}
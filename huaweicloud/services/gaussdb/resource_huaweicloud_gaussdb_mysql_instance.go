@@ -307,6 +307,18 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"parameters": common.ParametersSchema(),
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"switch_in_maintenance_window": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pending_restart_parameters": common.PendingRestartParametersSchema(),
 		},
 	}
 }
@@ -569,6 +581,10 @@ func resourceGaussDBInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if err := updateGaussDBMysqlParameters(d, client, d.Id()); err != nil {
+		return err
+	}
+
 	return resourceGaussDBInstanceRead(d, meta)
 }
 
@@ -620,6 +636,25 @@ func resourceGaussDBInstanceRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	params, err := getGaussDBMysqlParameters(client, instanceID)
+	if err != nil {
+		logp.Printf("[WARN] Unable to retrieve parameters of instance %s: %s", instanceID, err)
+	} else {
+		values := make(map[string]string)
+		for _, param := range params {
+			values[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, "").(string)
+		}
+		d.Set("parameters", common.FlattenConfiguredParameters(d, values))
+	}
+	// The modification histories record whether the parameter is applied, the restart may be delayed to the
+	// maintenance window, so the pending parameters are refreshed from the API.
+	historiesURL := client.ServiceURL("instances", instanceID, "configuration-histories")
+	if pending, err := common.GetPendingRestartParameters(client, historiesURL); err != nil {
+		logp.Printf("[WARN] Unable to retrieve parameter histories of instance %s: %s", instanceID, err)
+	} else {
+		d.Set("pending_restart_parameters", pending)
+	}
+
 	if instance.DedicatedResourceId != "" {
 		pages, err := instances.ListDeh(client).AllPages()
 		if err != nil {
@@ -1034,6 +1069,10 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if err := updateGaussDBMysqlParameters(d, client, instanceId); err != nil {
+		return err
+	}
+
 	if d.HasChange("auto_renew") {
 		bssClient, err := config.BssV2Client(config.GetRegion(d))
 		if err != nil {
@@ -1107,3 +1146,119 @@ func switchAuditLog(client *golangsdk.ServiceClient, instanceId string, v bool)
 
 	return nil
 }
+
+func getGaussDBMysqlParameters(client *golangsdk.ServiceClient, instanceId string) ([]interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("instances", instanceId, "configurations"), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("parameter_values", respBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+// updateGaussDBMysqlParameters applies the changed parameters to the instance, and restarts the instance by
+// restart_on_parameter_change if any parameter takes effect after the restart. The pending parameters are refreshed
+// from the parameter modification histories in Read.
+func updateGaussDBMysqlParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceId string) error {
+	pending := utils.ExpandToStringList(d.Get("pending_restart_parameters").([]interface{}))
+	values := common.ExpandChangedParameters(d)
+	if len(values) > 0 {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 202},
+			JSONBody: map[string]interface{}{
+				"parameter_values": values,
+			},
+		}
+		logp.Printf("[DEBUG] Update parameters of GaussDB instance %s: %#v", instanceId, values)
+		resp, err := client.Request("PUT", client.ServiceURL("instances", instanceId, "configurations"), &updateOpt)
+		if err != nil {
+			return fmtp.Errorf("error updating parameters of instance %s: %s", instanceId, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return err
+		}
+		if jobId := utils.PathSearch("job_id", respBody, "").(string); jobId != "" {
+			if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), jobId); err != nil {
+				return err
+			}
+		}
+
+		if utils.PathSearch("restart_required", respBody, false).(bool) {
+			params, err := getGaussDBMysqlParameters(client, instanceId)
+			if err != nil {
+				return fmtp.Errorf("error getting parameters of instance %s: %s", instanceId, err)
+			}
+			restartNames := make([]string, 0)
+			for _, param := range params {
+				name := utils.PathSearch("name", param, "").(string)
+				if _, ok := values[name]; ok && utils.PathSearch("restart_required", param, false).(bool) {
+					restartNames = append(restartNames, name)
+				}
+			}
+			pending = common.MergePendingRestartParameters(pending, restartNames)
+		}
+	}
+
+	if len(pending) > 0 && d.Get("restart_on_parameter_change").(bool) {
+		if err := restartGaussDBMysqlInstance(d, client, instanceId); err != nil {
+			return err
+		}
+		// The parameters are still pending until the delayed restart is performed in the maintenance window.
+		if !d.Get("switch_in_maintenance_window").(bool) {
+			pending = nil
+		}
+	}
+	return d.Set("pending_restart_parameters", pending)
+}
+
+// restartGaussDBMysqlInstance restarts the instance immediately and waits for it to become ACTIVE. If the
+// switch_in_maintenance_window is enabled, the restart is performed in the maintenance window and is not waited.
+func restartGaussDBMysqlInstance(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceId string) error {
+	isDelayed := d.Get("switch_in_maintenance_window").(bool)
+	restartOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"delay": isDelayed,
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances", instanceId, "restart"), &restartOpt)
+	if err != nil {
+		return fmtp.Errorf("error restarting instance %s: %s", instanceId, err)
+	}
+	if isDelayed {
+		logp.Printf("[DEBUG] The restart of instance %s will be performed in the maintenance window", instanceId)
+		return nil
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	if jobId := utils.PathSearch("job_id", respBody, "").(string); jobId != "" {
+		if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), jobId); err != nil {
+			return err
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"REBOOTING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    GaussDBInstanceStateRefreshFunc(client, instanceId),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmtp.Errorf("error waiting for instance %s to become ACTIVE after restart: %s", instanceId, err)
+	}
+	return nil
+}
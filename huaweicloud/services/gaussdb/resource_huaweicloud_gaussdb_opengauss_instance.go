@@ -286,6 +286,13 @@ func ResourceOpenGaussInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"parameters": common.ParametersSchema(),
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pending_restart_parameters": common.PendingRestartParametersSchema(),
 			"nodes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -505,6 +512,10 @@ func resourceOpenGaussInstanceCreate(ctx context.Context, d *schema.ResourceData
 	// This is a workaround to avoid db connection issue
 	time.Sleep(360 * time.Second) //lintignore:R018
 
+	if err = updateOpenGaussParameters(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceOpenGaussInstanceRead(ctx, d, meta)
 }
 
@@ -650,6 +661,23 @@ func resourceOpenGaussInstanceRead(_ context.Context, d *schema.ResourceData, me
 		setOpenGaussPrivateIpsAndEndpoints(d, instance.PrivateIps, instance.Port),
 	)
 
+	// The failure of the parameter queries is not fatal, the values in the state are kept.
+	if params, err := getOpenGaussParameters(client, instanceID); err != nil {
+		log.Printf("[WARN] error getting parameters of instance (%s): %s", instanceID, err)
+	} else {
+		values := make(map[string]string)
+		for _, param := range params {
+			values[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, "").(string)
+		}
+		mErr = multierror.Append(mErr, d.Set("parameters", common.FlattenConfiguredParameters(d, values)))
+	}
+	historiesURL := client.ServiceURL("instances", instanceID, "configuration-histories")
+	if pending, err := common.GetPendingRestartParameters(client, historiesURL); err != nil {
+		log.Printf("[WARN] error getting parameter histories of instance (%s): %s", instanceID, err)
+	} else {
+		mErr = multierror.Append(mErr, d.Set("pending_restart_parameters", pending))
+	}
+
 	if mErr.ErrorOrNil() != nil {
 		return diag.Errorf("error setting OpenGauss instance fields: %s", mErr.ErrorOrNil())
 	}
@@ -756,6 +784,99 @@ func updateVolumeAndRelatedHaNumbers(ctx context.Context, config *config.Config,
 	return nil
 }
 
+func getOpenGaussParameters(client *golangsdk.ServiceClient, instanceId string) ([]interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("instances", instanceId, "configurations"), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("configuration_parameters", respBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+func waitForOpenGaussInstanceActive(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	pending []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{"ACTIVE"},
+		Refresh:                   OpenGaussInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     20 * time.Second,
+		PollInterval:              20 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// updateOpenGaussParameters applies the changed parameters to the instance, and restarts the instance by
+// restart_on_parameter_change if any parameter takes effect after the restart. The pending parameters are refreshed
+// from the parameter modification histories in Read.
+func updateOpenGaussParameters(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	instanceId := d.Id()
+	pending := utils.ExpandToStringList(d.Get("pending_restart_parameters").([]interface{}))
+	values := common.ExpandChangedParameters(d)
+	if len(values) > 0 {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 202},
+			JSONBody: map[string]interface{}{
+				"parameter_values": values,
+			},
+		}
+		log.Printf("[DEBUG] Update parameters of OpenGauss instance (%s): %#v", instanceId, values)
+		resp, err := client.Request("PUT", client.ServiceURL("instances", instanceId, "configurations"), &updateOpt)
+		if err != nil {
+			return fmt.Errorf("error updating parameters of instance (%s): %s", instanceId, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return err
+		}
+		if err = waitForOpenGaussInstanceActive(ctx, d, client, []string{"MODIFYING"}); err != nil {
+			return fmt.Errorf("error waiting for instance (%s) parameters to be updated: %s", instanceId, err)
+		}
+
+		if utils.PathSearch("restart_required", respBody, false).(bool) {
+			params, err := getOpenGaussParameters(client, instanceId)
+			if err != nil {
+				return fmt.Errorf("error getting parameters of instance (%s): %s", instanceId, err)
+			}
+			restartNames := make([]string, 0)
+			for _, param := range params {
+				name := utils.PathSearch("name", param, "").(string)
+				if _, ok := values[name]; ok && utils.PathSearch("restart_required", param, false).(bool) {
+					restartNames = append(restartNames, name)
+				}
+			}
+			pending = common.MergePendingRestartParameters(pending, restartNames)
+		}
+	}
+
+	if len(pending) > 0 && d.Get("restart_on_parameter_change").(bool) {
+		restartOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 202},
+			JSONBody:         map[string]interface{}{},
+		}
+		_, err := client.Request("POST", client.ServiceURL("instances", instanceId, "restart"), &restartOpt)
+		if err != nil {
+			return fmt.Errorf("error restarting instance (%s): %s", instanceId, err)
+		}
+		if err = waitForOpenGaussInstanceActive(ctx, d, client, []string{"REBOOTING"}); err != nil {
+			return fmt.Errorf("error waiting for instance (%s) to become ACTIVE after restart: %s", instanceId, err)
+		}
+		pending = nil
+	}
+	return d.Set("pending_restart_parameters", pending)
+}
+
 func resourceOpenGaussInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
@@ -823,6 +944,10 @@ func resourceOpenGaussInstanceUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if err = updateOpenGaussParameters(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("auto_renew") {
 		bssClient, err := config.BssV2Client(region)
 		if err != nil {
@@ -0,0 +1,300 @@
+package hss
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceHosts is the impl of huaweicloud_hss_hosts, which queries the hosts with the agent status, the protection
+// status and the risk counts reported by HSS.
+func DataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the hosts are located.",
+			},
+			"host_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the host to be queried.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the hosts to be queried, fuzzy matching is supported.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status of the hosts to be queried.",
+			},
+			"os_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The operating system type of the hosts to be queried.",
+			},
+			"agent_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The agent status of the hosts to be queried.",
+			},
+			"protect_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The protection status of the hosts to be queried.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The protection version of the hosts to be queried.",
+			},
+			"charging_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The charging mode of the hosts to be queried.",
+			},
+			"policy_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the policy group deployed to the hosts to be queried.",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the host group to which the hosts to be queried belong.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the enterprise project to which the hosts to be queried belong.",
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        hostSchema(),
+				Description: "All hosts that match the filter parameters.",
+			},
+		},
+	}
+}
+
+func hostSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the host.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the host.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the host.",
+			},
+			"os_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system type of the host.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private IP address of the host.",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IP address of the host.",
+			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the agent installed on the host.",
+			},
+			"agent_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the agent.",
+			},
+			"agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the agent.",
+			},
+			"protect_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protection status of the host.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protection version of the host.",
+			},
+			"charging_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The charging mode of the protection.",
+			},
+			"quota_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the quota bound to the host.",
+			},
+			"detect_result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The security detection result of the host.",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the host group to which the host belongs.",
+			},
+			"policy_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the policy group deployed to the host.",
+			},
+			"policy_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the policy group deployed to the host.",
+			},
+			"asset_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The asset importance of the host.",
+			},
+			"asset_risk_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the asset risks.",
+			},
+			"vulnerability_risk_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the vulnerability risks.",
+			},
+			"baseline_risk_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the baseline risks.",
+			},
+			"intrusion_risk_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the intrusion risks.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the enterprise project to which the host belongs.",
+			},
+		},
+	}
+}
+
+func buildListHostsRequest(d *schema.ResourceData, region, epsId string) *hssv5model.ListHostStatusRequest {
+	return &hssv5model.ListHostStatusRequest{
+		Region:              utils.String(region),
+		EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+		HostId:              utils.StringIgnoreEmpty(d.Get("host_id").(string)),
+		HostName:            utils.StringIgnoreEmpty(d.Get("name").(string)),
+		HostStatus:          utils.StringIgnoreEmpty(d.Get("status").(string)),
+		OsType:              utils.StringIgnoreEmpty(d.Get("os_type").(string)),
+		AgentStatus:         utils.StringIgnoreEmpty(d.Get("agent_status").(string)),
+		ProtectStatus:       utils.StringIgnoreEmpty(d.Get("protect_status").(string)),
+		Version:             utils.StringIgnoreEmpty(d.Get("version").(string)),
+		ChargingMode:        utils.StringIgnoreEmpty(hostProtectionChargingModes[d.Get("charging_mode").(string)]),
+		PolicyGroupId:       utils.StringIgnoreEmpty(d.Get("policy_group_id").(string)),
+		GroupId:             utils.StringIgnoreEmpty(d.Get("group_id").(string)),
+	}
+}
+
+func flattenHosts(hosts []hssv5model.Host) ([]map[string]interface{}, []string) {
+	result := make([]map[string]interface{}, 0, len(hosts))
+	ids := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		chargingMode := ""
+		for k, v := range hostProtectionChargingModes {
+			if v == utils.StringValue(host.ChargingMode) {
+				chargingMode = k
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"id":                     host.HostId,
+			"name":                   host.HostName,
+			"status":                 host.HostStatus,
+			"os_type":                host.OsType,
+			"private_ip":             host.PrivateIp,
+			"public_ip":              host.PublicIp,
+			"agent_id":               host.AgentId,
+			"agent_status":           host.AgentStatus,
+			"agent_version":          host.AgentVersion,
+			"protect_status":         host.ProtectStatus,
+			"version":                host.Version,
+			"charging_mode":          chargingMode,
+			"quota_id":               host.ResourceId,
+			"detect_result":          host.DetectResult,
+			"group_id":               host.GroupId,
+			"policy_group_id":        host.PolicyGroupId,
+			"policy_group_name":      host.PolicyGroupName,
+			"asset_value":            host.AssetValue,
+			"asset_risk_num":         host.Asset,
+			"vulnerability_risk_num": host.Vulnerability,
+			"baseline_risk_num":      host.Baseline,
+			"intrusion_risk_num":     host.Intrusion,
+			"enterprise_project_id":  host.EnterpriseProjectId,
+		})
+		ids = append(ids, utils.StringValue(host.HostId))
+	}
+	return result, ids
+}
+
+func dataSourceHostsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		epsId  = common.GetEnterpriseProjectID(d, cfg)
+	)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	hosts, err := queryHosts(client, buildListHostsRequest(d, region, epsId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostList, ids := flattenHosts(hosts)
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("hosts", hostList),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS hosts fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+package hss
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hssv5 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5"
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	hostProtectionVersionNull = "hss.version.null"
+
+	agentStatusOnline = "online"
+)
+
+var hostProtectionVersions = []string{
+	"hss.version.basic", "hss.version.enterprise", "hss.version.premium", "hss.version.wtp",
+}
+
+// The charging modes of the HSS API are different from the other services.
+var hostProtectionChargingModes = map[string]string{
+	"prePaid":  "packet_cycle",
+	"postPaid": "on_demand",
+}
+
+// ResourceHostProtection is the impl of huaweicloud_hss_host_protection, which enables the protection of the host.
+// The agent of the host must be installed, and the protection is closed when the resource is destroyed.
+func ResourceHostProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHostProtectionCreate,
+		ReadContext:   resourceHostProtectionRead,
+		UpdateContext: resourceHostProtectionUpdate,
+		DeleteContext: resourceHostProtectionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the host is located.",
+			},
+			"host_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the host to be protected.",
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(hostProtectionVersions, false),
+				Description:  "The protection version.",
+			},
+			"charging_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"prePaid", "postPaid"}, false),
+				Description:  "The charging mode of the protection.",
+			},
+			"quota_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the quota to be bound to the host.",
+			},
+			"policy_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the policy group to be deployed to the host.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise project to which the host belongs.",
+			},
+			// Attributes
+			"host_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the host.",
+			},
+			"host_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the host.",
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private IP address of the host.",
+			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the agent installed on the host.",
+			},
+			"agent_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the agent.",
+			},
+			"os_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system type of the host.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protection status of the host.",
+			},
+			"detect_result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The security detection result of the host.",
+			},
+			"policy_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the policy group deployed to the host.",
+			},
+		},
+	}
+}
+
+// queryHosts queries all hosts matching the request, the offset and the limit of the request are overridden.
+func queryHosts(client *hssv5.HssClient, request *hssv5model.ListHostStatusRequest) ([]hssv5model.Host, error) {
+	var (
+		offset   int32 = 0
+		limit    int32 = 100
+		allHosts       = make([]hssv5model.Host, 0)
+	)
+	for {
+		request.Offset = utils.Int32(offset)
+		request.Limit = utils.Int32(limit)
+		response, err := client.ListHostStatus(request)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching hosts: %s", err)
+		}
+		if response == nil || response.DataList == nil || len(*response.DataList) == 0 {
+			break
+		}
+
+		allHosts = append(allHosts, *response.DataList...)
+		offset += int32(len(*response.DataList))
+		if response.TotalNum == nil || offset >= *response.TotalNum {
+			break
+		}
+	}
+	return allHosts, nil
+}
+
+// QueryHostById queries the host by the host ID, golangsdk.ErrDefault404 is returned if the host does not exist.
+func QueryHostById(client *hssv5.HssClient, region, epsId, hostId string) (*hssv5model.Host, error) {
+	hosts, err := queryHosts(client, &hssv5model.ListHostStatusRequest{
+		Region:              utils.String(region),
+		EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+		HostId:              utils.String(hostId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range hosts {
+		if host.HostId != nil && *host.HostId == hostId {
+			return &host, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("the host (%s) does not exist", hostId)),
+		},
+	}
+}
+
+func waitForHostAgentOnline(ctx context.Context, client *hssv5.HssClient, region, epsId, hostId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			host, err := QueryHostById(client, region, epsId, hostId)
+			if err != nil {
+				// The host is not reported until the agent is installed.
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "PENDING", nil
+				}
+				return nil, "ERROR", err
+			}
+			if host.AgentStatus != nil && *host.AgentStatus == agentStatusOnline {
+				return host, "COMPLETED", nil
+			}
+			return host, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the agent of the host (%s) to become online: %s", hostId, err)
+	}
+	return nil
+}
+
+// switchHostProtection switches the protection version of the host, the protection is closed if the version is
+// hss.version.null.
+func switchHostProtection(client *hssv5.HssClient, region, epsId, hostId, version, chargingMode,
+	quotaId string) error {
+	_, err := client.SwitchHostsProtectStatus(&hssv5model.SwitchHostsProtectStatusRequest{
+		Region:              region,
+		EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+		Body: &hssv5model.SwitchHostsProtectStatusRequestInfo{
+			Version:      utils.String(version),
+			ChargingMode: utils.StringIgnoreEmpty(hostProtectionChargingModes[chargingMode]),
+			ResourceId:   utils.StringIgnoreEmpty(quotaId),
+			HostIdList:   &[]string{hostId},
+		},
+	})
+	return err
+}
+
+func deployHostPolicyGroup(client *hssv5.HssClient, region, epsId, hostId, policyGroupId string) error {
+	_, err := client.AssociatePolicyGroup(&hssv5model.AssociatePolicyGroupRequest{
+		Region:              region,
+		EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+		Body: &hssv5model.AssociatePolicyGroupRequestInfo{
+			TargetPolicyGroupId: utils.String(policyGroupId),
+			HostIdList:          &[]string{hostId},
+		},
+	})
+	return err
+}
+
+func resourceHostProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	var (
+		hostId  = d.Get("host_id").(string)
+		version = d.Get("version").(string)
+		epsId   = common.GetEnterpriseProjectID(d, cfg)
+	)
+	err = waitForHostAgentOnline(ctx, client, region, epsId, hostId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = switchHostProtection(client, region, epsId, hostId, version, d.Get("charging_mode").(string),
+		d.Get("quota_id").(string))
+	if err != nil {
+		return diag.Errorf("error enabling the protection of the host (%s): %s", hostId, err)
+	}
+	d.SetId(hostId)
+
+	if v, ok := d.GetOk("policy_group_id"); ok {
+		if err = deployHostPolicyGroup(client, region, epsId, hostId, v.(string)); err != nil {
+			return diag.Errorf("error deploying the policy group to the host (%s): %s", hostId, err)
+		}
+	}
+
+	return resourceHostProtectionRead(ctx, d, meta)
+}
+
+func resourceHostProtectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		epsId  = common.GetEnterpriseProjectID(d, cfg)
+	)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	host, err := QueryHostById(client, region, epsId, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HSS host protection")
+	}
+	// The protection has been closed, the resource is removed from the state.
+	if utils.StringValue(host.ProtectStatus) == string(ProtectStatusClosed) ||
+		utils.StringValue(host.Version) == hostProtectionVersionNull {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving HSS host protection")
+	}
+
+	chargingMode := ""
+	for k, v := range hostProtectionChargingModes {
+		if v == utils.StringValue(host.ChargingMode) {
+			chargingMode = k
+		}
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("host_id", host.HostId),
+		d.Set("version", host.Version),
+		d.Set("charging_mode", chargingMode),
+		d.Set("quota_id", host.ResourceId),
+		d.Set("policy_group_id", host.PolicyGroupId),
+		d.Set("host_name", host.HostName),
+		d.Set("host_status", host.HostStatus),
+		d.Set("private_ip", host.PrivateIp),
+		d.Set("agent_id", host.AgentId),
+		d.Set("agent_status", host.AgentStatus),
+		d.Set("os_type", host.OsType),
+		d.Set("status", host.ProtectStatus),
+		d.Set("detect_result", host.DetectResult),
+		d.Set("policy_group_name", host.PolicyGroupName),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS host protection fields: %s", err)
+	}
+	return nil
+}
+
+func resourceHostProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		epsId  = common.GetEnterpriseProjectID(d, cfg)
+		hostId = d.Id()
+	)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	if d.HasChanges("version", "charging_mode", "quota_id") {
+		err = switchHostProtection(client, region, epsId, hostId, d.Get("version").(string),
+			d.Get("charging_mode").(string), d.Get("quota_id").(string))
+		if err != nil {
+			return diag.Errorf("error updating the protection of the host (%s): %s", hostId, err)
+		}
+	}
+
+	if d.HasChange("policy_group_id") {
+		if v, ok := d.GetOk("policy_group_id"); ok {
+			if err = deployHostPolicyGroup(client, region, epsId, hostId, v.(string)); err != nil {
+				return diag.Errorf("error deploying the policy group to the host (%s): %s", hostId, err)
+			}
+		}
+	}
+
+	return resourceHostProtectionRead(ctx, d, meta)
+}
+
+func resourceHostProtectionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		hostId = d.Id()
+	)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	err = switchHostProtection(client, region, common.GetEnterpriseProjectID(d, cfg), hostId,
+		hostProtectionVersionNull, "", "")
+	if err != nil {
+		return diag.Errorf("error closing the protection of the host (%s): %s", hostId, err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package hss
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	hssv5 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5"
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourcePolicyGroup is the impl of huaweicloud_hss_policy_group, the policy group is copied from an existing policy
+// group (such as the default policy group of the version), and deployed to the hosts by huaweicloud_hss_host_protection.
+func ResourcePolicyGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyGroupCreate,
+		ReadContext:   resourcePolicyGroupRead,
+		DeleteContext: resourcePolicyGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the policy group is located.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the policy group.",
+			},
+			"template_policy_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the policy group to be copied.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the policy group.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise project to which the policy group belongs.",
+			},
+			// Attributes
+			"deletable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the policy group can be deleted.",
+			},
+			"host_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the hosts to which the policy group is deployed.",
+			},
+			"default_group": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the policy group is a default policy group.",
+			},
+			"support_os": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system supported by the policy group.",
+			},
+			"support_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protection version supported by the policy group.",
+			},
+		},
+	}
+}
+
+// queryPolicyGroups queries all policy groups whose names contain the group name.
+func queryPolicyGroups(client *hssv5.HssClient, region, epsId, groupName string) (
+	[]hssv5model.PolicyGroupResponseInfo, error) {
+	var (
+		offset    int32 = 0
+		limit     int32 = 100
+		allGroups       = make([]hssv5model.PolicyGroupResponseInfo, 0)
+	)
+	for {
+		response, err := client.ListPolicyGroup(&hssv5model.ListPolicyGroupRequest{
+			Region:              region,
+			EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+			GroupName:           utils.StringIgnoreEmpty(groupName),
+			Offset:              utils.Int32(offset),
+			Limit:               utils.Int32(limit),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching policy groups: %s", err)
+		}
+		if response == nil || response.DataList == nil || len(*response.DataList) == 0 {
+			break
+		}
+
+		allGroups = append(allGroups, *response.DataList...)
+		offset += int32(len(*response.DataList))
+		if response.TotalNum == nil || offset >= *response.TotalNum {
+			break
+		}
+	}
+	return allGroups, nil
+}
+
+// QueryPolicyGroupById queries the policy group by the group ID, golangsdk.ErrDefault404 is returned if the policy
+// group does not exist.
+func QueryPolicyGroupById(client *hssv5.HssClient, region, epsId, groupId string) (
+	*hssv5model.PolicyGroupResponseInfo, error) {
+	groups, err := queryPolicyGroups(client, region, epsId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		if utils.StringValue(groups[i].GroupId) == groupId {
+			return &groups[i], nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{
+		ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+			Body: []byte(fmt.Sprintf("the policy group (%s) does not exist", groupId)),
+		},
+	}
+}
+
+func resourcePolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		epsId  = common.GetEnterpriseProjectID(d, cfg)
+		name   = d.Get("name").(string)
+	)
+	client, err := cfg.NewServiceClient("hss", region)
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v5/{project_id}/policy/group/copy"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	if epsId != "" {
+		createPath += fmt.Sprintf("?enterprise_project_id=%s", epsId)
+	}
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"group_name":               name,
+			"description":              utils.ValueIngoreEmpty(d.Get("description")),
+			"template_policy_group_id": d.Get("template_policy_group_id"),
+		}),
+	}
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating HSS policy group: %s", err)
+	}
+
+	// The copy API does not return the ID of the policy group, so the policy group is queried by the name.
+	hcClient, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+	groups, err := queryPolicyGroups(hcClient, region, epsId, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, group := range groups {
+		if utils.StringValue(group.GroupName) == name {
+			d.SetId(utils.StringValue(group.GroupId))
+			break
+		}
+	}
+	if d.Id() == "" {
+		return diag.Errorf("error creating HSS policy group: unable to find the policy group (%s)", name)
+	}
+
+	return resourcePolicyGroupRead(ctx, d, meta)
+}
+
+func resourcePolicyGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+		epsId  = common.GetEnterpriseProjectID(d, cfg)
+	)
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return diag.Errorf("error creating HSS v5 client: %s", err)
+	}
+
+	policyGroup, err := QueryPolicyGroupById(client, region, epsId, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HSS policy group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", policyGroup.GroupName),
+		d.Set("description", policyGroup.Description),
+		d.Set("deletable", policyGroup.Deletable),
+		d.Set("host_num", policyGroup.HostNum),
+		d.Set("default_group", policyGroup.DefaultGroup),
+		d.Set("support_os", policyGroup.SupportOs),
+		d.Set("support_version", policyGroup.SupportVersion),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS policy group fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePolicyGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg   = meta.(*config.Config)
+		epsId = common.GetEnterpriseProjectID(d, cfg)
+	)
+	client, err := cfg.NewServiceClient("hss", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v5/{project_id}/policy/group"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath += fmt.Sprintf("?group_id=%s", d.Id())
+	if epsId != "" {
+		deletePath += fmt.Sprintf("&enterprise_project_id=%s", epsId)
+	}
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting HSS policy group")
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package hss
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/bss/v2/resources"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	hssv5model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/hss/v5/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceQuotas is the impl of huaweicloud_hss_quotas, which purchases the prepaid protection quotas of the version,
+// the resource ID is the order ID. The quotas are bound to the hosts by huaweicloud_hss_host_protection.
+func ResourceQuotas() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQuotasCreate,
+		ReadContext:   resourceQuotasRead,
+		UpdateContext: resourceQuotasUpdate,
+		DeleteContext: resourceQuotasDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region where the quotas are located.",
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(hostProtectionVersions, false),
+				Description:  "The protection version of the quotas.",
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"month", "year"}, false),
+				Description:  "The charging period unit of the quotas.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+				Description:  "The charging period of the quotas.",
+			},
+			"auto_renew": common.SchemaAutoRenewUpdatable(nil),
+			"quantity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of the quotas to be purchased.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise project to which the quotas belong.",
+			},
+			"tags": common.TagsForceNewSchema(),
+			// Attributes
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the quota.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the quota.",
+						},
+						"used_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The usage status of the quota.",
+						},
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host to which the quota is bound.",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the host to which the quota is bound.",
+						},
+						"expire_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiration time of the quota.",
+						},
+					},
+				},
+				Description: "The purchased quotas.",
+			},
+		},
+	}
+}
+
+func buildQuotasOrderBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	periodType := 2
+	if d.Get("period_unit").(string) == "year" {
+		periodType = 3
+	}
+	bodyParams := map[string]interface{}{
+		"resource_spec_code":    d.Get("version"),
+		"period_type":           periodType,
+		"period_num":            d.Get("period"),
+		"is_auto_renew":         d.Get("auto_renew").(string) == "true",
+		"is_auto_pay":           true,
+		"subscription_num":      d.Get("quantity"),
+		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+	}
+	if tagList := utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})); len(tagList) > 0 {
+		bodyParams["tags"] = tagList
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+func resourceQuotasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("hss", region)
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v5/{project_id}/quotas/orders"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildQuotasOrderBodyParams(d, cfg),
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error purchasing HSS quotas: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	orderId := utils.PathSearch("order_id", respBody, "").(string)
+	if orderId == "" {
+		return diag.Errorf("error purchasing HSS quotas: order ID is not found in API response")
+	}
+	d.SetId(orderId)
+
+	bssClient, err := cfg.BssV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BSS v2 client: %s", err)
+	}
+	if err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err = common.WaitOrderResourceComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceQuotasRead(ctx, d, meta)
+}
+
+// queryQuotaIdsByOrder queries the IDs of the quotas purchased by the order.
+func queryQuotaIdsByOrder(cfg *config.Config, region, orderId string) ([]string, error) {
+	bssClient, err := cfg.BssV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BSS v2 client: %s", err)
+	}
+
+	resp, err := resources.List(bssClient, resources.ListOpts{
+		OrderId:          orderId,
+		OnlyMainResource: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying the resources of the order (%s): %s", orderId, err)
+	}
+
+	quotaIds := make([]string, 0, len(resp.Resources))
+	for _, r := range resp.Resources {
+		quotaIds = append(quotaIds, r.ResourceId)
+	}
+	return quotaIds, nil
+}
+
+// QueryQuotasByOrderId queries the quotas purchased by the order, golangsdk.ErrDefault404 is returned if all quotas
+// of the order have been unsubscribed.
+func QueryQuotasByOrderId(cfg *config.Config, region, epsId, orderId string) (
+	[]hssv5model.QuotaResourcesResponseInfo, error) {
+	client, err := cfg.HcHssV5Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HSS v5 client: %s", err)
+	}
+	quotaIds, err := queryQuotaIdsByOrder(cfg, region, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := make([]hssv5model.QuotaResourcesResponseInfo, 0, len(quotaIds))
+	for _, quotaId := range quotaIds {
+		response, err := client.ListQuotasDetail(&hssv5model.ListQuotasDetailRequest{
+			Region:              &region,
+			EnterpriseProjectId: utils.StringIgnoreEmpty(epsId),
+			ResourceId:          utils.String(quotaId),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error fetching HSS quota (%s): %s", quotaId, err)
+		}
+		if response.DataList != nil && len(*response.DataList) > 0 {
+			quotas = append(quotas, (*response.DataList)[0])
+		}
+	}
+	if len(quotas) == 0 {
+		return nil, golangsdk.ErrDefault404{
+			ErrUnexpectedResponseCode: golangsdk.ErrUnexpectedResponseCode{
+				Body: []byte(fmt.Sprintf("the quotas of the order (%s) do not exist", orderId)),
+			},
+		}
+	}
+	return quotas, nil
+}
+
+func flattenQuotas(quotas []hssv5model.QuotaResourcesResponseInfo) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(quotas))
+	for _, quota := range quotas {
+		expireTime := ""
+		if quota.ExpireTime != nil && *quota.ExpireTime > 0 {
+			expireTime = utils.FormatTimeStampRFC3339(*quota.ExpireTime/1000, false)
+		}
+		result = append(result, map[string]interface{}{
+			"id":          quota.ResourceId,
+			"status":      quota.QuotaStatus,
+			"used_status": quota.UsedStatus,
+			"host_id":     quota.HostId,
+			"host_name":   quota.HostName,
+			"expire_time": expireTime,
+		})
+	}
+	return result
+}
+
+func resourceQuotasRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var (
+		cfg    = meta.(*config.Config)
+		region = cfg.GetRegion(d)
+	)
+	quotas, err := QueryQuotasByOrderId(cfg, region, common.GetEnterpriseProjectID(d, cfg), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HSS quotas")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("version", quotas[0].Version),
+		d.Set("quantity", len(quotas)),
+		d.Set("quotas", flattenQuotas(quotas)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS quotas fields: %s", err)
+	}
+	return nil
+}
+
+func getQuotaIdsFromState(d *schema.ResourceData) []string {
+	quotas := d.Get("quotas").([]interface{})
+	quotaIds := make([]string, 0, len(quotas))
+	for _, v := range quotas {
+		quotaIds = append(quotaIds, v.(map[string]interface{})["id"].(string))
+	}
+	return quotaIds
+}
+
+func resourceQuotasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if d.HasChange("auto_renew") {
+		bssClient, err := cfg.BssV2Client(cfg.GetRegion(d))
+		if err != nil {
+			return diag.Errorf("error creating BSS v2 client: %s", err)
+		}
+		for _, quotaId := range getQuotaIdsFromState(d) {
+			if err = common.UpdateAutoRenew(bssClient, d.Get("auto_renew").(string), quotaId); err != nil {
+				return diag.Errorf("error updating the auto-renew of the HSS quota (%s): %s", quotaId, err)
+			}
+		}
+	}
+
+	return resourceQuotasRead(ctx, d, meta)
+}
+
+func resourceQuotasDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if err := common.UnsubscribePrePaidResource(d, cfg, getQuotaIdsFromState(d)); err != nil {
+		return diag.Errorf("error unsubscribing HSS quotas: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceIdentityPolicyDocument is the impl of data/huaweicloud_identity_policy_document, which builds the
+// canonical JSON of the IAM fine-grained policy locally, no API is called.
+func DataSourceIdentityPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdentityPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1.1",
+				ValidateFunc: validation.StringInSlice([]string{"1.1"}, false),
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+						},
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIdentityPolicyAction,
+							},
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIdentityPolicyConditionOperator,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildIdentityPolicyStatementConditions(rawConditions []interface{}) map[string]interface{} {
+	if len(rawConditions) == 0 {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	for _, v := range rawConditions {
+		condition := v.(map[string]interface{})
+		operator := condition["operator"].(string)
+		if _, ok := result[operator]; !ok {
+			result[operator] = make(map[string]interface{})
+		}
+		operatorConditions := result[operator].(map[string]interface{})
+
+		values := utils.ExpandToStringList(condition["values"].([]interface{}))
+		// The values of the same operator and key are merged.
+		if existing, ok := operatorConditions[condition["key"].(string)]; ok {
+			values = append(existing.([]string), values...)
+		}
+		operatorConditions[condition["key"].(string)] = values
+	}
+	return result
+}
+
+func buildIdentityPolicyStatements(rawStatements []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rawStatements))
+	for _, v := range rawStatements {
+		statement := v.(map[string]interface{})
+		params := map[string]interface{}{
+			"Effect": statement["effect"],
+			"Action": utils.ExpandToStringList(statement["actions"].([]interface{})),
+		}
+		if resources := utils.ExpandToStringList(statement["resources"].([]interface{})); len(resources) > 0 {
+			params["Resource"] = resources
+		}
+		if conditions := buildIdentityPolicyStatementConditions(statement["condition"].([]interface{})); conditions != nil {
+			params["Condition"] = conditions
+		}
+		result = append(result, params)
+	}
+	return result
+}
+
+func dataSourceIdentityPolicyDocumentRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	policy := map[string]interface{}{
+		"Version":   d.Get("version"),
+		"Statement": buildIdentityPolicyStatements(d.Get("statement").([]interface{})),
+	}
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return diag.Errorf("error marshaling policy document: %s", err)
+	}
+	document, err := utils.CanonicalizePolicy(string(b))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strconv.Itoa(hashcode.String(document)))
+
+	mErr := multierror.Append(nil,
+		d.Set("json", document),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
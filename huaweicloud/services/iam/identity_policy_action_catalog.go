@@ -0,0 +1,229 @@
+package iam
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// identityPolicyActionCatalog is the catalog of the service names and the resource types used in the actions of the
+// IAM fine-grained policies, the format of the actions is "<service>:<resource type>:<operation>". Only the service
+// name is validated if the list of the resource types is empty.
+var identityPolicyActionCatalog = map[string][]string{
+	"aad":                 nil,
+	"antiddos":            nil,
+	"aom":                 nil,
+	"apig":                nil,
+	"as":                  nil,
+	"bms":                 nil,
+	"bss":                 nil,
+	"cbh":                 nil,
+	"cbr":                 nil,
+	"cce":                 nil,
+	"cci":                 nil,
+	"cdm":                 nil,
+	"cdn":                 nil,
+	"ces":                 nil,
+	"cfw":                 nil,
+	"csms":                nil,
+	"css":                 nil,
+	"cts":                 nil,
+	"dbss":                nil,
+	"dcs":                 nil,
+	"ddm":                 nil,
+	"dds":                 nil,
+	"dis":                 nil,
+	"dli":                 nil,
+	"dms":                 nil,
+	"dns":                 nil,
+	"drs":                 nil,
+	"dws":                 nil,
+	"ecs":                 nil,
+	"elb":                 nil,
+	"eps":                 nil,
+	"er":                  nil,
+	"evs":                 nil,
+	"functiongraph":       nil,
+	"gaussdb":             nil,
+	"gaussdbforopengauss": nil,
+	"hss":                 nil,
+	"iam": {
+		"agencies", "credentials", "groups", "identityProviders", "mappings", "mfa", "permissions", "projects",
+		"protocols", "quotas", "roles", "securitypolicies", "tokens", "users",
+	},
+	"ims":           nil,
+	"kms":           nil,
+	"lts":           nil,
+	"modelarts":     nil,
+	"mrs":           nil,
+	"nat":           nil,
+	"nosql":         nil,
+	"obs":           {"bucket", "object"},
+	"organizations": nil,
+	"rds":           nil,
+	"rms":           nil,
+	"scm":           nil,
+	"secmaster":     nil,
+	"servicestage":  nil,
+	"sfs":           nil,
+	"sfsturbo":      nil,
+	"smn":           nil,
+	"swr":           nil,
+	"tms":           nil,
+	"vpc":           nil,
+	"vpcep":         nil,
+	"waf":           nil,
+}
+
+// identityPolicyOperationCatalog is the catalog of the operations of the commonly used resource types, the key is
+// "<service>:<resource type>". The catalog may not cover the newly released operations, so the unknown operations are
+// reported as warnings instead of errors.
+var identityPolicyOperationCatalog = map[string][]string{
+	"ecs:cloudServers": {
+		"addNics", "attach", "batchDeleteServerTags", "batchSetServerTags", "changeChargeMode", "changeOS",
+		"changeVpc", "create", "createServers", "delete", "deleteMetadata", "deleteNics", "deleteServers",
+		"detachVolume", "get", "getAutoRecovery", "list", "listServerBlockDevices", "listServerInterfaces",
+		"listServerVolumeAttachments", "lock", "migrate", "reboot", "rebuild", "resetPassword", "resize",
+		"setAutoRecovery", "showServerBlockDevice", "showServerTags", "start", "stop", "unlock", "updateMetadata",
+		"updateServer", "vncConsole",
+	},
+	"iam:agencies": {
+		"createAgency", "deleteAgency", "getAgency", "listAgencies", "updateAgency",
+	},
+	"iam:groups": {
+		"addUserToGroup", "checkUserInGroup", "createGroup", "deleteGroup", "getGroup", "listGroups",
+		"listUsersInGroup", "removeUserFromGroup", "updateGroup",
+	},
+	"iam:permissions": {
+		"checkRoleForAgency", "checkRoleForGroup", "grantRoleToAgency", "grantRoleToGroup",
+		"grantRoleToGroupOnDomain", "grantRoleToGroupOnEnterpriseProject", "grantRoleToGroupOnProject",
+		"grantRoleToUserOnEnterpriseProject", "listRolesForAgency", "listRolesForGroup",
+		"listRolesForGroupOnDomain", "listRolesForGroupOnEnterpriseProject", "listRolesForGroupOnProject",
+		"listRolesForUserOnEnterpriseProject", "revokeRoleFromAgency", "revokeRoleFromGroup",
+		"revokeRoleFromGroupOnDomain", "revokeRoleFromGroupOnEnterpriseProject", "revokeRoleFromGroupOnProject",
+		"revokeRoleFromUserOnEnterpriseProject",
+	},
+	"iam:projects": {
+		"createProject", "getProject", "listProjects", "listProjectsForUser", "updateProject",
+	},
+	"iam:roles": {
+		"createRole", "deleteRole", "getRole", "listRoles", "updateRole",
+	},
+	"iam:users": {
+		"createUser", "deleteUser", "getUser", "getUserLoginProtect", "listGroupsForUser", "listUsers",
+		"listUsersForEnterpriseProject", "setUserLoginProtect", "updateUser",
+	},
+	"obs:bucket": {
+		"CreateBucket", "DeleteBucket", "DeleteBucketCustomDomainConfiguration", "DeleteBucketInventoryConfiguration",
+		"DeleteBucketPolicy", "DeleteBucketTagging", "DeleteBucketWebsite", "DeleteDirectColdAccessConfiguration",
+		"DeleteReplicationConfiguration", "GetBucketAcl", "GetBucketCORS", "GetBucketCustomDomainConfiguration",
+		"GetBucketInventoryConfiguration", "GetBucketLocation", "GetBucketLogging", "GetBucketNotification",
+		"GetBucketObjectLockConfiguration", "GetBucketPolicy", "GetBucketQuota", "GetBucketStorage",
+		"GetBucketStoragePolicy", "GetBucketTagging", "GetBucketVersioning", "GetBucketWebsite",
+		"GetDirectColdAccessConfiguration", "GetEncryptionConfiguration", "GetLifecycleConfiguration",
+		"GetReplicationConfiguration", "HeadBucket", "ListAllMyBuckets", "ListBucket", "ListBucketMultipartUploads",
+		"ListBucketVersions", "PutBucketAcl", "PutBucketCORS", "PutBucketCustomDomainConfiguration",
+		"PutBucketInventoryConfiguration", "PutBucketLogging", "PutBucketNotification",
+		"PutBucketObjectLockConfiguration", "PutBucketPolicy", "PutBucketQuota", "PutBucketStoragePolicy",
+		"PutBucketTagging", "PutBucketVersioning", "PutBucketWebsite", "PutDirectColdAccessConfiguration",
+		"PutEncryptionConfiguration", "PutLifecycleConfiguration", "PutReplicationConfiguration",
+	},
+	"obs:object": {
+		"AbortMultipartUpload", "DeleteObject", "DeleteObjectVersion", "GetObject", "GetObjectAcl",
+		"GetObjectRetention", "GetObjectVersion", "GetObjectVersionAcl", "ListMultipartUploadParts",
+		"ModifyObjectMetaData", "PutObject", "PutObjectAcl", "PutObjectRetention", "PutObjectVersionAcl",
+		"RestoreObject",
+	},
+}
+
+var identityPolicyActionPartRegexp = regexp.MustCompile(`^[A-Za-z0-9*?]+$`)
+
+// validateIdentityPolicyAction checks whether the action matches the pattern "<service>:<resource type>:<operation>"
+// and the resource type exists in the catalog. The wildcards (* and ?) are allowed in each part, but the pattern with
+// wildcards must match at least one resource type of the catalog. The catalog does not cover all services, so only a
+// warning is returned if the service is unknown. The operation is checked against the operation catalog and a warning
+// is returned if it is unknown as well.
+func validateIdentityPolicyAction(v interface{}, k string) ([]string, []error) {
+	action := v.(string)
+	parts := strings.Split(action, ":")
+	if len(parts) != 3 {
+		return nil, []error{fmt.Errorf("%q must be in the format <service>:<resource type>:<operation>, but got %q",
+			k, action)}
+	}
+	for _, part := range parts {
+		if !identityPolicyActionPartRegexp.MatchString(part) {
+			return nil, []error{fmt.Errorf("%q contains invalid characters, only letters, digits and wildcards (* "+
+				"and ?) are allowed in each part, but got %q", k, action)}
+		}
+	}
+
+	service, resourceType := parts[0], parts[1]
+	serviceMatched, matched := false, false
+	for name, resourceTypes := range identityPolicyActionCatalog {
+		if ok, _ := path.Match(service, name); !ok {
+			continue
+		}
+		serviceMatched = true
+		if len(resourceTypes) == 0 || matchIdentityPolicyResourceType(resourceType, resourceTypes) {
+			matched = true
+			break
+		}
+	}
+	if !serviceMatched {
+		return []string{fmt.Sprintf("%q contains the service which is not in the catalog, please check the spelling, "+
+			"got %q", k, action)}, nil
+	}
+	if !matched {
+		return nil, []error{fmt.Errorf("%q contains the unknown service or resource type, got %q", k, action)}
+	}
+
+	// The operations are only checked if both the service name and the resource type are specified without wildcards.
+	operations := identityPolicyOperationCatalog[service+":"+resourceType]
+	if len(operations) > 0 && !matchIdentityPolicyOperation(parts[2], operations) {
+		return []string{fmt.Sprintf("%q contains the unknown operation of %s:%s, please check the spelling, got %q",
+			k, service, resourceType, action)}, nil
+	}
+	return nil, nil
+}
+
+func matchIdentityPolicyResourceType(pattern string, resourceTypes []string) bool {
+	for _, resourceType := range resourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIdentityPolicyOperation checks whether the operation pattern matches at least one operation, the operations are
+// compared case-insensitively.
+func matchIdentityPolicyOperation(pattern string, operations []string) bool {
+	for _, operation := range operations {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(operation)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// identityPolicyConditionOperators are the condition operators of the IAM fine-grained policies, the operators can
+// also be used with the prefix ForAnyValue: or ForAllValues: and the suffix IfExists.
+var identityPolicyConditionOperators = []string{
+	"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "StringLike",
+	"StringNotLike", "StringMatch", "StringNotMatch", "NumberEquals", "NumberNotEquals", "NumberLessThan",
+	"NumberLessThanEquals", "NumberGreaterThan", "NumberGreaterThanEquals", "DateLessThan", "DateLessThanEquals",
+	"DateGreaterThan", "DateGreaterThanEquals", "Bool", "IpAddress", "NotIpAddress", "IsNullOrEmpty", "Null",
+}
+
+func validateIdentityPolicyConditionOperator(v interface{}, k string) ([]string, []error) {
+	operator := v.(string)
+	name := strings.TrimPrefix(strings.TrimPrefix(operator, "ForAnyValue:"), "ForAllValues:")
+	name = strings.TrimSuffix(name, "IfExists")
+	for _, o := range identityPolicyConditionOperators {
+		if name == o {
+			return nil, nil
+		}
+	}
+	return nil, []error{fmt.Errorf("%q contains the unknown condition operator, got %q", k, operator)}
+}
@@ -0,0 +1,160 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceIdentityLoginPolicy is the impl of huaweicloud_identity_login_policy, which manages the login
+// authentication policy of the account. The policy is a singleton of the account, it is reset to the default values
+// when the resource is destroyed.
+func ResourceIdentityLoginPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityLoginPolicyUpdate,
+		ReadContext:   resourceIdentityLoginPolicyRead,
+		UpdateContext: resourceIdentityLoginPolicyUpdate,
+		DeleteContext: resourceIdentityLoginPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_validity_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 240),
+			},
+			"custom_info_for_login": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"lockout_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(15, 1440),
+			},
+			"login_failed_times": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(3, 10),
+			},
+			"period_with_login_failures": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(15, 60),
+			},
+			"session_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(15, 1440),
+			},
+			"show_recent_login_info": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func updateIdentityLoginPolicy(cfg *config.Config, d *schema.ResourceData, opts *iam.LoginPolicyOption) error {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	request := iam.UpdateDomainLoginPolicyRequest{
+		DomainId: cfg.DomainID,
+		Body: &iam.UpdateDomainLoginPolicyRequestBody{
+			LoginPolicy: opts,
+		},
+	}
+	_, err = client.UpdateDomainLoginPolicy(&request)
+	return err
+}
+
+func resourceIdentityLoginPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if cfg.DomainID == "" {
+		return diag.Errorf("the domain_id must be specified in the provider configuration")
+	}
+
+	opts := iam.LoginPolicyOption{
+		AccountValidityPeriod:   utils.Int32(int32(d.Get("account_validity_period").(int))),
+		CustomInfoForLogin:      utils.String(d.Get("custom_info_for_login").(string)),
+		LockoutDuration:         utils.Int32(int32(d.Get("lockout_duration").(int))),
+		LoginFailedTimes:        utils.Int32(int32(d.Get("login_failed_times").(int))),
+		PeriodWithLoginFailures: utils.Int32(int32(d.Get("period_with_login_failures").(int))),
+		SessionTimeout:          utils.Int32(int32(d.Get("session_timeout").(int))),
+		ShowRecentLoginInfo:     utils.Bool(d.Get("show_recent_login_info").(bool)),
+	}
+	if err := updateIdentityLoginPolicy(cfg, d, &opts); err != nil {
+		return diag.Errorf("error updating IAM login policy: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(cfg.DomainID)
+	}
+	return resourceIdentityLoginPolicyRead(ctx, d, meta)
+}
+
+func resourceIdentityLoginPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	response, err := client.ShowDomainLoginPolicy(&iam.ShowDomainLoginPolicyRequest{DomainId: d.Id()})
+	if err != nil {
+		return diag.Errorf("error fetching IAM login policy: %s", err)
+	}
+	policy := response.LoginPolicy
+	if policy == nil {
+		return diag.Errorf("error fetching IAM login policy: the policy is not found in API response")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("account_validity_period", policy.AccountValidityPeriod),
+		d.Set("custom_info_for_login", policy.CustomInfoForLogin),
+		d.Set("lockout_duration", policy.LockoutDuration),
+		d.Set("login_failed_times", policy.LoginFailedTimes),
+		d.Set("period_with_login_failures", policy.PeriodWithLoginFailures),
+		d.Set("session_timeout", policy.SessionTimeout),
+		d.Set("show_recent_login_info", policy.ShowRecentLoginInfo),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM login policy fields: %s", err)
+	}
+	return nil
+}
+
+func resourceIdentityLoginPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Reset the login policy to the default values.
+	opts := iam.LoginPolicyOption{
+		AccountValidityPeriod:   utils.Int32(0),
+		CustomInfoForLogin:      utils.String(""),
+		LockoutDuration:         utils.Int32(15),
+		LoginFailedTimes:        utils.Int32(5),
+		PeriodWithLoginFailures: utils.Int32(15),
+		SessionTimeout:          utils.Int32(60),
+		ShowRecentLoginInfo:     utils.Bool(false),
+	}
+	if err := updateIdentityLoginPolicy(meta.(*config.Config), d, &opts); err != nil {
+		return diag.Errorf("error resetting IAM login policy: %s", err)
+	}
+	return nil
+}
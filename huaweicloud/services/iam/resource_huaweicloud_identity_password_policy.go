@@ -0,0 +1,170 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceIdentityPasswordPolicy is the impl of huaweicloud_identity_password_policy, which manages the password
+// policy of the account. The policy is a singleton of the account, it is reset to the default values when the
+// resource is destroyed.
+func ResourceIdentityPasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityPasswordPolicyUpdate,
+		ReadContext:   resourceIdentityPasswordPolicyRead,
+		UpdateContext: resourceIdentityPasswordPolicyUpdate,
+		DeleteContext: resourceIdentityPasswordPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"password_char_combination": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(2, 4),
+			},
+			"minimum_password_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(6, 32),
+			},
+			"maximum_consecutive_identical_chars": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"number_of_recent_passwords_disallowed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 10),
+			},
+			"password_validity_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 180),
+			},
+			"minimum_password_age": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+			"password_not_username_or_invert": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"maximum_password_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"password_requirements": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func updateIdentityPasswordPolicy(cfg *config.Config, d *schema.ResourceData, opts *iam.PasswordPolicyOption) error {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	request := iam.UpdateDomainPasswordPolicyRequest{
+		DomainId: cfg.DomainID,
+		Body: &iam.UpdateDomainPasswordPolicyRequestBody{
+			PasswordPolicy: opts,
+		},
+	}
+	_, err = client.UpdateDomainPasswordPolicy(&request)
+	return err
+}
+
+func resourceIdentityPasswordPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if cfg.DomainID == "" {
+		return diag.Errorf("the domain_id must be specified in the provider configuration")
+	}
+
+	opts := iam.PasswordPolicyOption{
+		PasswordCharCombination:           utils.Int32(int32(d.Get("password_char_combination").(int))),
+		MinimumPasswordLength:             utils.Int32(int32(d.Get("minimum_password_length").(int))),
+		MaximumConsecutiveIdenticalChars:  utils.Int32(int32(d.Get("maximum_consecutive_identical_chars").(int))),
+		NumberOfRecentPasswordsDisallowed: utils.Int32(int32(d.Get("number_of_recent_passwords_disallowed").(int))),
+		PasswordValidityPeriod:            utils.Int32(int32(d.Get("password_validity_period").(int))),
+		MinimumPasswordAge:                utils.Int32(int32(d.Get("minimum_password_age").(int))),
+		PasswordNotUsernameOrInvert:       utils.Bool(d.Get("password_not_username_or_invert").(bool)),
+	}
+	if err := updateIdentityPasswordPolicy(cfg, d, &opts); err != nil {
+		return diag.Errorf("error updating IAM password policy: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(cfg.DomainID)
+	}
+	return resourceIdentityPasswordPolicyRead(ctx, d, meta)
+}
+
+func resourceIdentityPasswordPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	response, err := client.ShowDomainPasswordPolicy(&iam.ShowDomainPasswordPolicyRequest{DomainId: d.Id()})
+	if err != nil {
+		return diag.Errorf("error fetching IAM password policy: %s", err)
+	}
+	policy := response.PasswordPolicy
+	if policy == nil {
+		return diag.Errorf("error fetching IAM password policy: the policy is not found in API response")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("password_char_combination", policy.PasswordCharCombination),
+		d.Set("minimum_password_length", policy.MinimumPasswordLength),
+		d.Set("maximum_consecutive_identical_chars", policy.MaximumConsecutiveIdenticalChars),
+		d.Set("number_of_recent_passwords_disallowed", policy.NumberOfRecentPasswordsDisallowed),
+		d.Set("password_validity_period", policy.PasswordValidityPeriod),
+		d.Set("minimum_password_age", policy.MinimumPasswordAge),
+		d.Set("password_not_username_or_invert", policy.PasswordNotUsernameOrInvert),
+		d.Set("maximum_password_length", policy.MaximumPasswordLength),
+		d.Set("password_requirements", policy.PasswordRequirements),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM password policy fields: %s", err)
+	}
+	return nil
+}
+
+func resourceIdentityPasswordPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Reset the password policy to the default values.
+	opts := iam.PasswordPolicyOption{
+		PasswordCharCombination:           utils.Int32(2),
+		MinimumPasswordLength:             utils.Int32(8),
+		MaximumConsecutiveIdenticalChars:  utils.Int32(0),
+		NumberOfRecentPasswordsDisallowed: utils.Int32(1),
+		PasswordValidityPeriod:            utils.Int32(0),
+		MinimumPasswordAge:                utils.Int32(0),
+		PasswordNotUsernameOrInvert:       utils.Bool(true),
+	}
+	if err := updateIdentityPasswordPolicy(meta.(*config.Config), d, &opts); err != nil {
+		return diag.Errorf("error resetting IAM password policy: %s", err)
+	}
+	return nil
+}
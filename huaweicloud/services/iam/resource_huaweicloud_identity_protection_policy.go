@@ -0,0 +1,216 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceIdentityProtectionPolicy is the impl of huaweicloud_identity_protection_policy, which manages the operation
+// protection and the self-management permissions of the IAM users. The policy is a singleton of the account, the
+// operation protection is disabled and all self-management permissions are granted when the resource is destroyed.
+func ResourceIdentityProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityProtectionPolicyUpdate,
+		ReadContext:   resourceIdentityProtectionPolicyRead,
+		UpdateContext: resourceIdentityProtectionPolicyUpdate,
+		DeleteContext: resourceIdentityProtectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"protection_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"verification_mobile": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"verification_email"},
+			},
+			"verification_email": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"verification_mobile"},
+			},
+			"self_management": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"password": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"email": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"mobile": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"self_verification": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func updateIdentityProtectionPolicy(cfg *config.Config, d *schema.ResourceData, opts *iam.ProtectPolicyOption) error {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	request := iam.UpdateDomainProtectPolicyRequest{
+		DomainId: cfg.DomainID,
+		Body: &iam.UpdateDomainProtectPolicyRequestBody{
+			ProtectPolicy: opts,
+		},
+	}
+	_, err = client.UpdateDomainProtectPolicy(&request)
+	return err
+}
+
+func buildIdentityProtectionPolicySelfManagement(rawParams []interface{}) *iam.AllowUserBody {
+	// All self-management permissions are granted by default.
+	result := iam.AllowUserBody{
+		ManageAccesskey: utils.Bool(true),
+		ManagePassword:  utils.Bool(true),
+		ManageEmail:     utils.Bool(true),
+		ManageMobile:    utils.Bool(true),
+	}
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return &result
+	}
+
+	params := rawParams[0].(map[string]interface{})
+	result.ManageAccesskey = utils.Bool(params["access_key"].(bool))
+	result.ManagePassword = utils.Bool(params["password"].(bool))
+	result.ManageEmail = utils.Bool(params["email"].(bool))
+	result.ManageMobile = utils.Bool(params["mobile"].(bool))
+	return &result
+}
+
+func resourceIdentityProtectionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	if cfg.DomainID == "" {
+		return diag.Errorf("the domain_id must be specified in the provider configuration")
+	}
+
+	opts := iam.ProtectPolicyOption{
+		OperationProtection: d.Get("protection_enabled").(bool),
+		AllowUser:           buildIdentityProtectionPolicySelfManagement(d.Get("self_management").([]interface{})),
+		// The operation is verified by the operator self if the mobile and email are both omitted.
+		AdminCheck: utils.String("off"),
+	}
+	if v, ok := d.GetOk("verification_mobile"); ok {
+		opts.AdminCheck = utils.String("on")
+		opts.Scene = utils.String("mobile")
+		opts.Mobile = utils.String(v.(string))
+	} else if v, ok := d.GetOk("verification_email"); ok {
+		opts.AdminCheck = utils.String("on")
+		opts.Scene = utils.String("email")
+		opts.Email = utils.String(v.(string))
+	}
+	if err := updateIdentityProtectionPolicy(cfg, d, &opts); err != nil {
+		return diag.Errorf("error updating IAM protection policy: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(cfg.DomainID)
+	}
+	return resourceIdentityProtectionPolicyRead(ctx, d, meta)
+}
+
+func flattenIdentityProtectionPolicySelfManagement(allowUser *iam.AllowUserBody) []map[string]interface{} {
+	if allowUser == nil {
+		return nil
+	}
+
+	boolValue := func(v *bool) bool {
+		return v == nil || *v
+	}
+	return []map[string]interface{}{
+		{
+			"access_key": boolValue(allowUser.ManageAccesskey),
+			"password":   boolValue(allowUser.ManagePassword),
+			"email":      boolValue(allowUser.ManageEmail),
+			"mobile":     boolValue(allowUser.ManageMobile),
+		},
+	}
+}
+
+func resourceIdentityProtectionPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	response, err := client.ShowDomainProtectPolicy(&iam.ShowDomainProtectPolicyRequest{DomainId: d.Id()})
+	if err != nil {
+		return diag.Errorf("error fetching IAM protection policy: %s", err)
+	}
+	policy := response.ProtectPolicy
+	if policy == nil {
+		return diag.Errorf("error fetching IAM protection policy: the policy is not found in API response")
+	}
+
+	var mobile, email string
+	if policy.AdminCheck == "on" {
+		switch policy.Scene {
+		case "mobile":
+			mobile = policy.Mobile
+		case "email":
+			email = policy.Email
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("protection_enabled", policy.OperationProtection),
+		d.Set("verification_mobile", mobile),
+		d.Set("verification_email", email),
+		d.Set("self_management", flattenIdentityProtectionPolicySelfManagement(policy.AllowUser)),
+		d.Set("self_verification", policy.AdminCheck != "on"),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM protection policy fields: %s", err)
+	}
+	return nil
+}
+
+func resourceIdentityProtectionPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	opts := iam.ProtectPolicyOption{
+		OperationProtection: false,
+		AllowUser:           buildIdentityProtectionPolicySelfManagement(nil),
+		AdminCheck:          utils.String("off"),
+	}
+	if err := updateIdentityProtectionPolicy(meta.(*config.Config), d, &opts); err != nil {
+		return diag.Errorf("error resetting IAM protection policy: %s", err)
+	}
+	return nil
+}
@@ -40,13 +40,10 @@ func ResourceIdentityRole() *schema.Resource {
 				Required: true,
 			},
 			"policy": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsJSON,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
-					return equal
-				},
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.SuppressEquivalentAwsPolicyDiffs,
 			},
 			"references": {
 				Type:     schema.TypeInt,
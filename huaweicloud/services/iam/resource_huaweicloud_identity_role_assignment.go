@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/logp"
 )
@@ -29,21 +31,38 @@ func ResourceIdentityRoleAssignmentV3() *schema.Resource {
 				ForceNew: true,
 			},
 			"group_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"group_id", "user_id"},
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"enterprise_project_id"},
 			},
 			"domain_id": {
-				Type:          schema.TypeString,
-				ConflictsWith: []string{"project_id"},
-				Optional:      true,
-				ForceNew:      true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"domain_id", "project_id", "enterprise_project_id"},
 			},
 			"project_id": {
-				Type:          schema.TypeString,
-				ConflictsWith: []string{"domain_id"},
-				Optional:      true,
-				ForceNew:      true,
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"all_projects": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"domain_id", "group_id"},
 			},
 		},
 	}
@@ -51,16 +70,38 @@ func ResourceIdentityRoleAssignmentV3() *schema.Resource {
 
 func resourceIdentityRoleAssignmentV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
-	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
-	if err != nil {
-		return fmtp.DiagErrorf("Error creating HuaweiCloud identity client: %s", err)
-	}
-
 	roleID := d.Get("role_id").(string)
 	groupID := d.Get("group_id").(string)
 	domainID := d.Get("domain_id").(string)
 	projectID := d.Get("project_id").(string)
 
+	if _, ok := d.GetOk("enterprise_project_id"); ok || d.Get("all_projects").(bool) {
+		client, err := config.IAMNoVersionClient(config.GetRegion(d))
+		if err != nil {
+			return diag.Errorf("error creating IAM client without version: %s", err)
+		}
+
+		assignOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+		}
+		if _, err = client.Request("PUT", buildRoleAssignmentPath(client, d), &assignOpt); err != nil {
+			return diag.Errorf("error assigning role: %s", err)
+		}
+
+		if epsID, ok := d.GetOk("enterprise_project_id"); ok {
+			d.SetId(fmt.Sprintf("%s/%s/%s", epsID, getRoleAssignmentSubjectID(d), roleID))
+		} else {
+			d.SetId(buildRoleAssignmentID(domainID, roleAssignmentAllProjects, groupID, roleID))
+		}
+		return resourceIdentityRoleAssignmentV3Read(ctx, d, meta)
+	}
+
+	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloud identity client: %s", err)
+	}
+
 	opts := roles.AssignOpts{
 		GroupID:   groupID,
 		DomainID:  domainID,
@@ -79,6 +120,20 @@ func resourceIdentityRoleAssignmentV3Create(ctx context.Context, d *schema.Resou
 
 func resourceIdentityRoleAssignmentV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
+
+	if _, ok := d.GetOk("enterprise_project_id"); ok || d.Get("all_projects").(bool) {
+		client, err := config.IAMNoVersionClient(config.GetRegion(d))
+		if err != nil {
+			return diag.Errorf("error creating IAM client without version: %s", err)
+		}
+
+		// The assignment revoked out of band is removed from the state, so it will be granted again.
+		if err = checkRoleAssignmentExists(client, d); err != nil {
+			return common.CheckDeletedDiag(d, err, "error retrieving role assignment")
+		}
+		return nil
+	}
+
 	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
 	if err != nil {
 		return fmtp.DiagErrorf("Error creating HuaweiCloud identity client: %s", err)
@@ -88,6 +143,9 @@ func resourceIdentityRoleAssignmentV3Read(_ context.Context, d *schema.ResourceD
 	if err != nil {
 		return fmtp.DiagErrorf("Error getting role assignment: %s", err)
 	}
+	if roleAssignment.ID == "" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "role assignment")
+	}
 	domainID, projectID, groupID, _ := ExtractRoleAssignmentID(d.Id())
 
 	logp.Printf("[DEBUG] Retrieved HuaweiCloud role assignment: %#v", roleAssignment)
@@ -107,6 +165,23 @@ func resourceIdentityRoleAssignmentV3Read(_ context.Context, d *schema.ResourceD
 
 func resourceIdentityRoleAssignmentV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
+
+	if _, ok := d.GetOk("enterprise_project_id"); ok || d.Get("all_projects").(bool) {
+		client, err := config.IAMNoVersionClient(config.GetRegion(d))
+		if err != nil {
+			return diag.Errorf("error creating IAM client without version: %s", err)
+		}
+
+		unassignOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+		}
+		if _, err = client.Request("DELETE", buildRoleAssignmentPath(client, d), &unassignOpt); err != nil {
+			return common.CheckDeletedDiag(d, err, "error unassigning role")
+		}
+		return nil
+	}
+
 	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
 	if err != nil {
 		return fmtp.DiagErrorf("Error creating HuaweiCloud identity client: %s", err)
@@ -157,6 +232,66 @@ func getRoleAssignment(identityClient *golangsdk.ServiceClient, d *schema.Resour
 	return assignment, err
 }
 
+// roleAssignmentAllProjects is used as the project ID part of the resource ID if the role is assigned to all
+// projects, including the projects created later.
+const roleAssignmentAllProjects = "all"
+
+func getRoleAssignmentSubjectID(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("user_id"); ok {
+		return v.(string)
+	}
+	return d.Get("group_id").(string)
+}
+
+// buildRoleAssignmentPath returns the path of the assignment with the enterprise project scope or the inherited scope
+// of all projects, the client must be the IAM client without version.
+func buildRoleAssignmentPath(client *golangsdk.ServiceClient, d *schema.ResourceData) string {
+	roleID := d.Get("role_id").(string)
+	if epsID, ok := d.GetOk("enterprise_project_id"); ok {
+		subjectType := "groups"
+		if _, ok := d.GetOk("user_id"); ok {
+			subjectType = "users"
+		}
+		return client.Endpoint + fmt.Sprintf("v3.0/OS-PERMISSION/enterprise-projects/%s/%s/%s/roles/%s",
+			epsID, subjectType, getRoleAssignmentSubjectID(d), roleID)
+	}
+	return client.Endpoint + fmt.Sprintf("v3/OS-INHERIT/domains/%s/groups/%s/roles/%s/inherited_to_projects",
+		d.Get("domain_id"), d.Get("group_id"), roleID)
+}
+
+// checkRoleAssignmentExists returns a 404 error if the assignment with the enterprise project scope or the inherited
+// scope of all projects does not exist.
+func checkRoleAssignmentExists(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	if _, ok := d.GetOk("enterprise_project_id"); !ok {
+		checkOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+		}
+		_, err := client.Request("HEAD", buildRoleAssignmentPath(client, d), &checkOpt)
+		return err
+	}
+
+	// The roles of the subject on the enterprise project are listed by the parent path of the assignment.
+	assignmentPath := buildRoleAssignmentPath(client, d)
+	listPath := assignmentPath[:strings.LastIndex(assignmentPath, "/")]
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	if utils.PathSearch(fmt.Sprintf("roles[?id=='%s']|[0]", d.Get("role_id")), respBody, nil) == nil {
+		return golangsdk.ErrDefault404{}
+	}
+	return nil
+}
+
 // Role assignments have no ID in HuaweiCloud. Build an ID out of the IDs that make up the role assignment
 func buildRoleAssignmentID(domainID, projectID, groupID, roleID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", domainID, projectID, groupID, roleID)
@@ -2,6 +2,8 @@ package iam
 
 import (
 	"context"
+	"log"
+	"net/http"
 	"regexp"
 	"strings"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
@@ -76,6 +80,11 @@ func ResourceIdentityUserV3() *schema.Resource {
 					"default", "programmatic", "console",
 				}, false),
 			},
+			"verification_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"sms", "email", "vmfa"}, false),
+			},
 			"password_strength": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +137,12 @@ func resourceIdentityUserV3Create(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(user.ID)
 
+	if v, ok := d.GetOk("verification_method"); ok {
+		if err = updateIdentityUserLoginProtect(config, d, v.(string)); err != nil {
+			return diag.Errorf("error enabling login protection of IAM user (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIdentityUserV3Read(ctx, d, meta)
 }
 
@@ -158,6 +173,16 @@ func resourceIdentityUserV3Read(_ context.Context, d *schema.ResourceData, meta
 		d.Set("last_login", user.LastLogin),
 	)
 
+	// The login protection is only queried when it is managed by this resource, because the API requires additional
+	// permissions.
+	if _, ok := d.GetOk("verification_method"); ok {
+		verificationMethod, err := getIdentityUserVerificationMethod(config, d)
+		if err != nil {
+			return diag.Errorf("error fetching login protection of IAM user (%s): %s", d.Id(), err)
+		}
+		mErr = multierror.Append(mErr, d.Set("verification_method", verificationMethod))
+	}
+
 	phone := strings.Split(user.Phone, "-")
 	if len(phone) > 1 {
 		mErr = multierror.Append(mErr, d.Set("phone", phone[1]))
@@ -224,9 +249,72 @@ func resourceIdentityUserV3Update(ctx context.Context, d *schema.ResourceData, m
 		return fmtp.DiagErrorf("Error updating HuaweiCloud user: %s", err)
 	}
 
+	if d.HasChange("verification_method") {
+		if err = updateIdentityUserLoginProtect(config, d, d.Get("verification_method").(string)); err != nil {
+			return diag.Errorf("error updating login protection of IAM user (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIdentityUserV3Read(ctx, d, meta)
 }
 
+// updateIdentityUserLoginProtect enables the login protection with the verification method, or disables the login
+// protection if the method is empty.
+func updateIdentityUserLoginProtect(cfg *config.Config, d *schema.ResourceData, method string) error {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	verificationMethod := method
+	if method == "" {
+		// The verification method is required even if the login protection is disabled, so the old one is used.
+		oldMethod, _ := d.GetChange("verification_method")
+		verificationMethod = oldMethod.(string)
+	}
+
+	request := iam.UpdateLoginProtectRequest{
+		UserId: d.Id(),
+		Body: &iam.UpdateLoginProjectReq{
+			LoginProtect: &iam.UpdateLoginProject{
+				Enabled:            method != "",
+				VerificationMethod: verificationMethod,
+			},
+		},
+	}
+	_, err = client.UpdateLoginProtect(&request)
+	return err
+}
+
+// getIdentityUserVerificationMethod returns the verification method of the login protection, an empty string is
+// returned if the login protection is not configured or disabled. The value in the state is kept if the login
+// protection is not allowed to be queried.
+func getIdentityUserVerificationMethod(cfg *config.Config, d *schema.ResourceData) (string, error) {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.ShowUserLoginProtect(&iam.ShowUserLoginProtectRequest{UserId: d.Id()})
+	if err != nil {
+		if responseErr, ok := err.(*sdkerr.ServiceResponseError); ok {
+			switch responseErr.StatusCode {
+			case http.StatusNotFound:
+				return "", nil
+			case http.StatusForbidden:
+				log.Printf("[WARN] unable to fetch the login protection of IAM user (%s): %s", d.Id(), err)
+				return d.Get("verification_method").(string), nil
+			}
+		}
+		return "", err
+	}
+
+	if response.LoginProtect == nil || !response.LoginProtect.Enabled {
+		return "", nil
+	}
+	return response.LoginProtect.VerificationMethod, nil
+}
+
 func resourceIdentityUserV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	identityClient, err := config.IdentityV3Client(config.GetRegion(d))
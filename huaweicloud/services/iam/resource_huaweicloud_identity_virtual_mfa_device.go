@@ -0,0 +1,205 @@
+package iam
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceIdentityVirtualMfaDevice is the impl of huaweicloud_identity_virtual_mfa_device, which creates a virtual
+// MFA device for an IAM user and binds it to the user with two consecutive authentication codes.
+// The codes are generated by the authenticator from the secret seed, so the device is bound in the next apply after
+// the authentication codes are specified.
+func ResourceIdentityVirtualMfaDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityVirtualMfaDeviceCreate,
+		ReadContext:   resourceIdentityVirtualMfaDeviceRead,
+		UpdateContext: resourceIdentityVirtualMfaDeviceUpdate,
+		DeleteContext: resourceIdentityVirtualMfaDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"authentication_code_first": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"authentication_code_second"},
+			},
+			"authentication_code_second": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"authentication_code_first"},
+			},
+			"base32_string_seed": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityVirtualMfaDeviceCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	request := iam.CreateMfaDeviceRequest{
+		Body: &iam.CreateMfaDeviceReq{
+			VirtualMfaDevice: &iam.CreateMfaDevice{
+				Name:   d.Get("name").(string),
+				UserId: d.Get("user_id").(string),
+			},
+		},
+	}
+	response, err := client.CreateMfaDevice(&request)
+	if err != nil {
+		return diag.Errorf("error creating IAM virtual MFA device: %s", err)
+	}
+	if response.VirtualMfaDevice == nil {
+		return diag.Errorf("error creating IAM virtual MFA device: the device is not found in API response")
+	}
+
+	d.SetId(response.VirtualMfaDevice.SerialNumber)
+	// The secret seed is only returned when the device is created, and the authentication codes generated from it
+	// can not be known before, so the device is always bound in the update.
+	if err = d.Set("base32_string_seed", response.VirtualMfaDevice.Base32StringSeed); err != nil {
+		return diag.Errorf("error setting the secret seed of IAM virtual MFA device: %s", err)
+	}
+	return resourceIdentityVirtualMfaDeviceRead(ctx, d, meta)
+}
+
+func bindIdentityVirtualMfaDevice(cfg *config.Config, d *schema.ResourceData) error {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return err
+	}
+
+	request := iam.CreateBindingDeviceRequest{
+		Body: &iam.BindMfaDevice{
+			UserId:                   d.Get("user_id").(string),
+			SerialNumber:             d.Id(),
+			AuthenticationCodeFirst:  d.Get("authentication_code_first").(string),
+			AuthenticationCodeSecond: d.Get("authentication_code_second").(string),
+		},
+	}
+	_, err = client.CreateBindingDevice(&request)
+	return err
+}
+
+func resourceIdentityVirtualMfaDeviceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	response, err := client.ListUserMfaDevices(&iam.ListUserMfaDevicesRequest{})
+	if err != nil {
+		return diag.Errorf("error fetching IAM virtual MFA devices: %s", err)
+	}
+
+	var device *iam.MfaDeviceResult
+	if response.VirtualMfaDevices != nil {
+		for i, v := range *response.VirtualMfaDevices {
+			if v.SerialNumber == d.Id() {
+				device = &(*response.VirtualMfaDevices)[i]
+				break
+			}
+		}
+	}
+	if device == nil {
+		return common.CheckDeletedDiag(d, &sdkerr.ServiceResponseError{StatusCode: http.StatusNotFound},
+			"error fetching IAM virtual MFA device")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("user_id", device.UserId),
+		d.Set("enabled", isIdentityVirtualMfaDeviceBound(cfg, d, device.UserId)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM virtual MFA device fields: %s", err)
+	}
+	return nil
+}
+
+// isIdentityVirtualMfaDeviceBound checks whether the device is the one bound to the user, the value in the state is
+// kept if the binding is not allowed to be queried.
+func isIdentityVirtualMfaDeviceBound(cfg *config.Config, d *schema.ResourceData, userId string) bool {
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		log.Printf("[WARN] error creating IAM client: %s", err)
+		return d.Get("enabled").(bool)
+	}
+
+	response, err := client.ShowUserMfaDevice(&iam.ShowUserMfaDeviceRequest{UserId: userId})
+	if err != nil {
+		if responseErr, ok := err.(*sdkerr.ServiceResponseError); ok && responseErr.StatusCode == http.StatusNotFound {
+			return false
+		}
+		log.Printf("[WARN] unable to fetch the virtual MFA device bound to IAM user (%s): %s", userId, err)
+		return d.Get("enabled").(bool)
+	}
+	return response.VirtualMfaDevice != nil && response.VirtualMfaDevice.SerialNumber == d.Id()
+}
+
+func resourceIdentityVirtualMfaDeviceUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	if d.HasChanges("authentication_code_first", "authentication_code_second") {
+		// The authentication codes can only be used once, removing them does not unbind the device.
+		if _, ok := d.GetOk("authentication_code_first"); ok {
+			if err := bindIdentityVirtualMfaDevice(meta.(*config.Config), d); err != nil {
+				return diag.Errorf("error binding IAM virtual MFA device (%s): %s", d.Id(), err)
+			}
+		}
+	}
+	return resourceIdentityVirtualMfaDeviceRead(ctx, d, meta)
+}
+
+func resourceIdentityVirtualMfaDeviceDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.HcIamV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	request := iam.DeleteMfaDeviceRequest{
+		UserId:       d.Get("user_id").(string),
+		SerialNumber: d.Id(),
+	}
+	if _, err = client.DeleteMfaDevice(&request); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting IAM virtual MFA device")
+	}
+	return nil
+}
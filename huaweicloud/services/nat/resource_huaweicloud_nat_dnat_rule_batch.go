@@ -0,0 +1,532 @@
+package nat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDnatRuleBatch is used to manage a group of DNAT rules of a public NAT gateway in one resource.
+// The rules are created with the batch creation API and updated by comparing the old and new rule sets.
+// The resource ID is generated, so that more than one group of rules can be managed under the same NAT gateway.
+func ResourceDnatRuleBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDnatRuleBatchCreate,
+		ReadContext:   resourceDnatRuleBatchRead,
+		UpdateContext: resourceDnatRuleBatchUpdate,
+		DeleteContext: resourceDnatRuleBatchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDnatRuleBatchImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the NAT gateway is located.`,
+			},
+			"nat_gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the public NAT gateway to which the DNAT rules belong.`,
+			},
+			"rules": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Set:         resourceDnatRuleBatchRuleHash,
+				Elem:        dnatRuleBatchRuleSchema(),
+				Description: `The DNAT rules managed by this resource.`,
+			},
+		},
+	}
+}
+
+func dnatRuleBatchRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"floating_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the EIP used by the DNAT rule.`,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "any",
+				}, false),
+				Description: `The protocol type of the DNAT rule.`,
+			},
+			"internal_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `The port used by the backend instance to provide services.`,
+			},
+			"external_service_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `The port used by the EIP to provide services for external systems.`,
+			},
+			"internal_service_port_range": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The port range used by the backend instance to provide services, e.g. 1000-1010.`,
+			},
+			"external_service_port_range": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The port range used by the EIP to provide services for external systems.`,
+			},
+			"port_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The port ID of the backend instance.`,
+			},
+			"private_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The private IP address of the backend instance.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the DNAT rule.`,
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the DNAT rule.`,
+			},
+			"floating_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The address of the EIP used by the DNAT rule.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the DNAT rule.`,
+			},
+		},
+	}
+}
+
+// resourceDnatRuleBatchRuleHash only hashes the user-specified fields, so that a rule keeps the same hash code before
+// and after the computed attributes are filled in.
+func resourceDnatRuleBatchRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	for _, key := range []string{"floating_ip_id", "protocol", "internal_service_port_range",
+		"external_service_port_range", "port_id", "private_ip", "description"} {
+		if val, ok := m[key]; ok && val != nil {
+			buf.WriteString(fmt.Sprintf("%s-", val.(string)))
+		}
+	}
+	for _, key := range []string{"internal_service_port", "external_service_port"} {
+		if val, ok := m[key]; ok && val != nil {
+			buf.WriteString(fmt.Sprintf("%d-", val.(int)))
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func buildDnatRuleBatchRuleParams(gatewayId string, rule map[string]interface{}) map[string]interface{} {
+	params := map[string]interface{}{
+		"nat_gateway_id":              gatewayId,
+		"floating_ip_id":              rule["floating_ip_id"],
+		"protocol":                    rule["protocol"],
+		"port_id":                     utils.ValueIngoreEmpty(rule["port_id"]),
+		"private_ip":                  utils.ValueIngoreEmpty(rule["private_ip"]),
+		"internal_service_port_range": utils.ValueIngoreEmpty(rule["internal_service_port_range"]),
+		"external_service_port_range": utils.ValueIngoreEmpty(rule["external_service_port_range"]),
+		"description":                 utils.ValueIngoreEmpty(rule["description"]),
+	}
+	// The port number 0 means any port, so the value is always sent if no port range is specified.
+	if rule["internal_service_port_range"] == "" {
+		params["internal_service_port"] = rule["internal_service_port"]
+		params["external_service_port"] = rule["external_service_port"]
+	}
+	return utils.RemoveNil(params)
+}
+
+func createDnatRulesInBatch(client *golangsdk.ServiceClient, gatewayId string,
+	rules []interface{}) ([]interface{}, error) {
+	if len(rules) < 1 {
+		return nil, nil
+	}
+
+	ruleParams := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ruleParams = append(ruleParams, buildDnatRuleBatchRuleParams(gatewayId, rule.(map[string]interface{})))
+	}
+
+	createPath := client.Endpoint + "v2/{project_id}/dnat_rules/batch"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"dnat_rules": ruleParams,
+		},
+		OkCodes: []int{201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// The rules in the response are returned in the same order as they are requested.
+	createdRules := utils.PathSearch("dnat_rules", respBody, make([]interface{}, 0)).([]interface{})
+	if len(createdRules) != len(rules) {
+		return nil, fmt.Errorf("expected %d DNAT rules to be created, but got %d", len(rules), len(createdRules))
+	}
+	result := make([]interface{}, 0, len(rules))
+	for i, rule := range rules {
+		m := copyDnatRuleBatchRule(rule.(map[string]interface{}))
+		m["id"] = utils.PathSearch("id", createdRules[i], "")
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func copyDnatRuleBatchRule(rule map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(rule))
+	for k, v := range rule {
+		result[k] = v
+	}
+	return result
+}
+
+func deleteDnatRule(client *golangsdk.ServiceClient, gatewayId, ruleId string) error {
+	deletePath := client.Endpoint + "v2/{project_id}/nat_gateways/{nat_gateway_id}/dnat_rules/{dnat_rule_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{nat_gateway_id}", gatewayId)
+	deletePath = strings.ReplaceAll(deletePath, "{dnat_rule_id}", ruleId)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err := client.Request("DELETE", deletePath, &deleteOpt)
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		return nil
+	}
+	return err
+}
+
+// listDnatRules returns all DNAT rules of the NAT gateway, the key of the result map is the rule ID.
+func listDnatRules(client *golangsdk.ServiceClient, gatewayId string) (map[string]interface{}, error) {
+	listPath := client.Endpoint + "v2/{project_id}/dnat_rules"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath += fmt.Sprintf("?nat_gateway_id=%s&limit=200", gatewayId)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make(map[string]interface{})
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath += fmt.Sprintf("&marker=%s", marker)
+		}
+		resp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		rules := utils.PathSearch("dnat_rules", respBody, make([]interface{}, 0)).([]interface{})
+		for _, rule := range rules {
+			result[utils.PathSearch("id", rule, "").(string)] = rule
+		}
+		if len(rules) < 200 {
+			break
+		}
+		marker = utils.PathSearch("id", rules[len(rules)-1], "").(string)
+	}
+	return result, nil
+}
+
+func dnatRulesStatusRefreshFunc(client *golangsdk.ServiceClient, gatewayId string,
+	ruleIds []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rules, err := listDnatRules(client, gatewayId)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		for _, ruleId := range ruleIds {
+			rule, ok := rules[ruleId]
+			if !ok {
+				return nil, "ERROR", fmt.Errorf("unable to find the DNAT rule (%s)", ruleId)
+			}
+			status := utils.PathSearch("status", rule, "").(string)
+			if status == "ERROR" {
+				return rule, "ERROR", fmt.Errorf("the DNAT rule (%s) is in unexpected status '%s'", ruleId, status)
+			}
+			if status != "ACTIVE" {
+				log.Printf("[DEBUG] The DNAT rule (%s) is in status %s, waiting for it to become ACTIVE", ruleId, status)
+				return rules, "PENDING", nil
+			}
+		}
+		return rules, "COMPLETED", nil
+	}
+}
+
+func waitForDnatRulesActive(ctx context.Context, client *golangsdk.ServiceClient, gatewayId string,
+	rules []interface{}, timeout time.Duration) error {
+	ruleIds := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, utils.PathSearch("id", rule, "").(string))
+	}
+	if len(ruleIds) < 1 {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      dnatRulesStatusRefreshFunc(client, gatewayId, ruleIds),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDnatRuleBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	createdRules, err := createDnatRulesInBatch(client, gatewayId, d.Get("rules").(*schema.Set).List())
+	if err != nil {
+		return diag.Errorf("error creating DNAT rules in batch: %s", err)
+	}
+	resourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(resourceId)
+
+	if err = d.Set("rules", createdRules); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = waitForDnatRulesActive(ctx, client, gatewayId, createdRules, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the DNAT rules to become active: %s", err)
+	}
+
+	return resourceDnatRuleBatchRead(ctx, d, meta)
+}
+
+// flattenDnatRuleBatchRule converts the remote rule to the schema format, the backend is described by either
+// port_id or private_ip, which is the same as the one in the prior rule (if exists).
+func flattenDnatRuleBatchRule(rule, priorRule interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                          utils.PathSearch("id", rule, nil),
+		"floating_ip_id":              utils.PathSearch("floating_ip_id", rule, nil),
+		"floating_ip_address":         utils.PathSearch("floating_ip_address", rule, nil),
+		"protocol":                    utils.PathSearch("protocol", rule, nil),
+		"internal_service_port":       int(utils.PathSearch("internal_service_port", rule, float64(0)).(float64)),
+		"external_service_port":       int(utils.PathSearch("external_service_port", rule, float64(0)).(float64)),
+		"internal_service_port_range": utils.PathSearch("internal_service_port_range", rule, nil),
+		"external_service_port_range": utils.PathSearch("external_service_port_range", rule, nil),
+		"port_id":                     utils.PathSearch("port_id", rule, nil),
+		"private_ip":                  utils.PathSearch("private_ip", rule, nil),
+		"description":                 utils.PathSearch("description", rule, nil),
+		"status":                      utils.PathSearch("status", rule, nil),
+	}
+	switch {
+	case utils.PathSearch("port_id", priorRule, "").(string) != "":
+		delete(result, "private_ip")
+	case utils.PathSearch("private_ip", priorRule, "").(string) != "":
+		delete(result, "port_id")
+	case utils.PathSearch("port_id", rule, "").(string) != "":
+		delete(result, "private_ip")
+	}
+	return result
+}
+
+func resourceDnatRuleBatchRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("nat", region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	remoteRules, err := listDnatRules(client, gatewayId)
+	if err != nil {
+		return diag.Errorf("error querying DNAT rules of the NAT gateway (%s): %s", gatewayId, err)
+	}
+
+	// Only the rules managed by this resource are refreshed, the rules which have been deleted out of band are
+	// removed from the state so that they will be recreated in the next apply.
+	rules := make([]interface{}, 0)
+	for _, rule := range d.Get("rules").(*schema.Set).List() {
+		ruleId := utils.PathSearch("id", rule, "").(string)
+		if remoteRule, ok := remoteRules[ruleId]; ok {
+			rules = append(rules, flattenDnatRuleBatchRule(remoteRule, rule))
+		} else {
+			log.Printf("[WARN] The DNAT rule (%s) is not found, it will be removed from the state", ruleId)
+		}
+	}
+	if len(rules) < 1 {
+		resourceId := d.Id()
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Resource not found",
+				Detail: fmt.Sprintf("none of the DNAT rules managed by the resource (%s) are found under the NAT "+
+					"gateway (%s), the resource will be removed", resourceId, gatewayId),
+			},
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("rules", rules),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving DNAT rules fields of the NAT gateway (%s): %s", gatewayId, err)
+	}
+	return nil
+}
+
+func resourceDnatRuleBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := d.Get("nat_gateway_id").(string)
+	oldRaw, newRaw := d.GetChange("rules")
+	oldRules, newRules := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+	removedRules := oldRules.Difference(newRules)
+	addedRules := newRules.Difference(oldRules)
+
+	// Remove the rules first, so that the ports released by the removed rules can be used by the new rules.
+	for _, rule := range removedRules.List() {
+		ruleId := utils.PathSearch("id", rule, "").(string)
+		if err = deleteDnatRule(client, gatewayId, ruleId); err != nil {
+			return diag.Errorf("error deleting DNAT rule (%s): %s", ruleId, err)
+		}
+	}
+
+	createdRules, err := createDnatRulesInBatch(client, gatewayId, addedRules.List())
+	if err != nil {
+		return diag.Errorf("error creating DNAT rules in batch: %s", err)
+	}
+
+	// The unchanged rules keep the IDs stored in the state.
+	rules := oldRules.Intersection(newRules).List()
+	rules = append(rules, createdRules...)
+	if err = d.Set("rules", rules); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = waitForDnatRulesActive(ctx, client, gatewayId, createdRules, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the DNAT rules to become active: %s", err)
+	}
+
+	return resourceDnatRuleBatchRead(ctx, d, meta)
+}
+
+func resourceDnatRuleBatchDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	var mErr *multierror.Error
+	gatewayId := d.Get("nat_gateway_id").(string)
+	for _, rule := range d.Get("rules").(*schema.Set).List() {
+		ruleId := utils.PathSearch("id", rule, "").(string)
+		if err = deleteDnatRule(client, gatewayId, ruleId); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("error deleting DNAT rule (%s): %s", ruleId, err))
+		}
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// resourceDnatRuleBatchImportState imports the specified DNAT rules of the NAT gateway, the format of the import ID is
+// <nat_gateway_id>/<rule_id>[,<rule_id>...].
+func resourceDnatRuleBatchImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, want '<nat_gateway_id>/<rule_id>[,<rule_id>...]', "+
+			"but got '%s'", d.Id())
+	}
+
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	gatewayId := parts[0]
+	remoteRules, err := listDnatRules(client, gatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("error querying DNAT rules of the NAT gateway (%s): %s", gatewayId, err)
+	}
+	rules := make([]interface{}, 0)
+	for _, ruleId := range strings.Split(parts[1], ",") {
+		rule, ok := remoteRules[ruleId]
+		if !ok {
+			return nil, fmt.Errorf("unable to find the DNAT rule (%s) under the NAT gateway (%s)", ruleId, gatewayId)
+		}
+		rules = append(rules, flattenDnatRuleBatchRule(rule, nil))
+	}
+
+	resourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(resourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("nat_gateway_id", gatewayId),
+		d.Set("rules", rules),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
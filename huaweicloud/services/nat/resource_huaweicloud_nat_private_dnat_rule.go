@@ -0,0 +1,266 @@
+package nat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateDnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateDnatRuleCreate,
+		ReadContext:   resourcePrivateDnatRuleRead,
+		UpdateContext: resourcePrivateDnatRuleUpdate,
+		DeleteContext: resourcePrivateDnatRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the DNAT rule is located.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the private NAT gateway to which the DNAT rule belongs.`,
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the transit IP associated with the DNAT rule.`,
+			},
+			"backend_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"backend_private_ip"},
+				Description:  `The network interface ID of the backend instance.`,
+			},
+			"backend_private_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				Description:  `The private IP address of the backend instance.`,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "any",
+				}, false),
+				Description: `The protocol type of the DNAT rule.`,
+			},
+			"internal_service_port": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"transit_service_port"},
+				Description:  `The port or port range used by the backend instance to provide services.`,
+			},
+			"transit_service_port": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"internal_service_port"},
+				Description:  `The port or port range of the transit IP.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the DNAT rule.`,
+			},
+			"backend_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the backend instance.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the enterprise project to which the DNAT rule belongs.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the DNAT rule.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the DNAT rule.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the DNAT rule.`,
+			},
+		},
+	}
+}
+
+func buildPrivateDnatRuleBodyParams(d *schema.ResourceData, isCreate bool) map[string]interface{} {
+	ruleParams := map[string]interface{}{
+		"transit_ip_id":         d.Get("transit_ip_id"),
+		"network_interface_id":  utils.ValueIngoreEmpty(d.Get("backend_interface_id")),
+		"private_ip_address":    utils.ValueIngoreEmpty(d.Get("backend_private_ip")),
+		"protocol":              utils.ValueIngoreEmpty(d.Get("protocol")),
+		"internal_service_port": utils.ValueIngoreEmpty(d.Get("internal_service_port")),
+		"transit_service_port":  utils.ValueIngoreEmpty(d.Get("transit_service_port")),
+	}
+	if isCreate {
+		ruleParams["gateway_id"] = d.Get("gateway_id")
+		ruleParams["description"] = utils.ValueIngoreEmpty(d.Get("description"))
+	} else {
+		ruleParams["description"] = d.Get("description")
+		// Only one of the backend parameters can be specified.
+		if d.HasChange("backend_private_ip") && !d.HasChange("backend_interface_id") {
+			delete(ruleParams, "network_interface_id")
+		} else {
+			delete(ruleParams, "private_ip_address")
+		}
+	}
+	return map[string]interface{}{
+		"dnat_rule": utils.RemoveNil(ruleParams),
+	}
+}
+
+func resourcePrivateDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildPrivateDnatRuleBodyParams(d, true),
+		OkCodes:          []int{201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating private DNAT rule: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId := utils.PathSearch("dnat_rule.id", respBody, "").(string)
+	if ruleId == "" {
+		return diag.Errorf("unable to find the private DNAT rule ID from the API response")
+	}
+	d.SetId(ruleId)
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateDnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("nat", region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{rule_id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private DNAT rule")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", utils.PathSearch("dnat_rule.gateway_id", respBody, nil)),
+		d.Set("transit_ip_id", utils.PathSearch("dnat_rule.transit_ip_id", respBody, nil)),
+		d.Set("backend_interface_id", utils.PathSearch("dnat_rule.network_interface_id", respBody, nil)),
+		d.Set("backend_private_ip", utils.PathSearch("dnat_rule.private_ip_address", respBody, nil)),
+		d.Set("backend_type", utils.PathSearch("dnat_rule.type", respBody, nil)),
+		d.Set("protocol", utils.PathSearch("dnat_rule.protocol", respBody, nil)),
+		d.Set("internal_service_port", utils.PathSearch("dnat_rule.internal_service_port", respBody, nil)),
+		d.Set("transit_service_port", utils.PathSearch("dnat_rule.transit_service_port", respBody, nil)),
+		d.Set("description", utils.PathSearch("dnat_rule.description", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("dnat_rule.enterprise_project_id", respBody, nil)),
+		d.Set("status", utils.PathSearch("dnat_rule.status", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("dnat_rule.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("dnat_rule.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private DNAT rule (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourcePrivateDnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{rule_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{rule_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildPrivateDnatRuleBodyParams(d, false),
+		OkCodes:          []int{200},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating private DNAT rule (%s): %s", d.Id(), err)
+	}
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateDnatRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{rule_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{rule_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private DNAT rule")
+	}
+	return nil
+}
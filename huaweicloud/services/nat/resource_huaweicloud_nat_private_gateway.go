@@ -0,0 +1,312 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateGatewayCreate,
+		ReadContext:   resourcePrivateGatewayRead,
+		UpdateContext: resourcePrivateGatewayUpdate,
+		DeleteContext: resourcePrivateGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the private NAT gateway is located.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the subnet to which the private NAT gateway belongs.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  `The name of the private NAT gateway.`,
+			},
+			"spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small", "Medium", "Large", "Extra-large",
+				}, false),
+				Description: `The specification of the private NAT gateway.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the private NAT gateway.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the enterprise project to which the private NAT gateway belongs.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the VPC to which the private NAT gateway belongs.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the private NAT gateway.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the private NAT gateway.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the private NAT gateway.`,
+			},
+		},
+	}
+}
+
+func buildPrivateGatewayCreateBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	gatewayParams := map[string]interface{}{
+		"name": d.Get("name"),
+		"downlink_vpcs": []map[string]interface{}{
+			{
+				"virsubnet_id": d.Get("subnet_id"),
+			},
+		},
+		"spec":                  utils.ValueIngoreEmpty(d.Get("spec")),
+		"description":           utils.ValueIngoreEmpty(d.Get("description")),
+		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+		"tags":                  utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+	}
+	return map[string]interface{}{
+		"gateway": utils.RemoveNil(gatewayParams),
+	}
+}
+
+func resourcePrivateGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("nat", region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/gateways"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildPrivateGatewayCreateBodyParams(d, cfg),
+		OkCodes:          []int{201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating private NAT gateway: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayId := utils.PathSearch("gateway.id", respBody, "").(string)
+	if gatewayId == "" {
+		return diag.Errorf("unable to find the private NAT gateway ID from the API response")
+	}
+	d.SetId(gatewayId)
+
+	err = waitForPrivateGatewayStatusCompleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the private NAT gateway (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourcePrivateGatewayRead(ctx, d, meta)
+}
+
+func getPrivateGateway(client *golangsdk.ServiceClient, gatewayId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/gateways/{gateway_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{gateway_id}", gatewayId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func privateGatewayStatusRefreshFunc(client *golangsdk.ServiceClient, gatewayId string,
+	targets []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getPrivateGateway(client, gatewayId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok && len(targets) < 1 {
+				return "deleted", "COMPLETED", nil
+			}
+			return nil, "ERROR", err
+		}
+
+		status := utils.PathSearch("gateway.status", respBody, "").(string)
+		log.Printf("[DEBUG] The status of the private NAT gateway (%s) is: %s", gatewayId, status)
+		if status == "FROZEN" {
+			return respBody, "ERROR", fmt.Errorf("unexpected status '%s'", status)
+		}
+		if utils.StrSliceContains(targets, status) {
+			return respBody, "COMPLETED", nil
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForPrivateGatewayStatusCompleted(ctx context.Context, client *golangsdk.ServiceClient, gatewayId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      privateGatewayStatusRefreshFunc(client, gatewayId, []string{"ACTIVE"}),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourcePrivateGatewayRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("nat", region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	respBody, err := getPrivateGateway(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private NAT gateway")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", utils.PathSearch("gateway.downlink_vpcs[0].virsubnet_id", respBody, nil)),
+		d.Set("vpc_id", utils.PathSearch("gateway.downlink_vpcs[0].vpc_id", respBody, nil)),
+		d.Set("name", utils.PathSearch("gateway.name", respBody, nil)),
+		d.Set("spec", utils.PathSearch("gateway.spec", respBody, nil)),
+		d.Set("description", utils.PathSearch("gateway.description", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("gateway.enterprise_project_id", respBody, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("gateway.tags", respBody, nil))),
+		d.Set("status", utils.PathSearch("gateway.status", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("gateway.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("gateway.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private NAT gateway (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourcePrivateGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/private-nat/gateways/{gateway_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{gateway_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"gateway": utils.RemoveNil(map[string]interface{}{
+				"name":        d.Get("name"),
+				"spec":        utils.ValueIngoreEmpty(d.Get("spec")),
+				"description": d.Get("description"),
+			}),
+		},
+		OkCodes: []int{200},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating private NAT gateway (%s): %s", d.Id(), err)
+	}
+
+	err = waitForPrivateGatewayStatusCompleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error waiting for the private NAT gateway (%s) update to complete: %s", d.Id(), err)
+	}
+
+	return resourcePrivateGatewayRead(ctx, d, meta)
+}
+
+func resourcePrivateGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/gateways/{gateway_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{gateway_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private NAT gateway")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      privateGatewayStatusRefreshFunc(client, d.Id(), nil),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the private NAT gateway (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package nat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateSnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateSnatRuleCreate,
+		ReadContext:   resourcePrivateSnatRuleRead,
+		UpdateContext: resourcePrivateSnatRuleUpdate,
+		DeleteContext: resourcePrivateSnatRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region where the SNAT rule is located.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the private NAT gateway to which the SNAT rule belongs.`,
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the transit IP associated with the SNAT rule.`,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id"},
+				ValidateFunc: validation.IsCIDR,
+				Description:  `The CIDR block of the matching rule.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The ID of the subnet of the matching rule.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the SNAT rule.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the enterprise project to which the SNAT rule belongs.`,
+			},
+			"transit_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The IP address of the transit IP associated with the SNAT rule.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the SNAT rule.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the SNAT rule.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the SNAT rule.`,
+			},
+		},
+	}
+}
+
+func buildPrivateSnatRuleCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	ruleParams := map[string]interface{}{
+		"gateway_id":     d.Get("gateway_id"),
+		"transit_ip_ids": []string{d.Get("transit_ip_id").(string)},
+		"cidr":           utils.ValueIngoreEmpty(d.Get("cidr")),
+		"virsubnet_id":   utils.ValueIngoreEmpty(d.Get("subnet_id")),
+		"description":    utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	return map[string]interface{}{
+		"snat_rule": utils.RemoveNil(ruleParams),
+	}
+}
+
+func resourcePrivateSnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildPrivateSnatRuleCreateBodyParams(d),
+		OkCodes:          []int{201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating private SNAT rule: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId := utils.PathSearch("snat_rule.id", respBody, "").(string)
+	if ruleId == "" {
+		return diag.Errorf("unable to find the private SNAT rule ID from the API response")
+	}
+	d.SetId(ruleId)
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateSnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("nat", region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{rule_id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "private SNAT rule")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", utils.PathSearch("snat_rule.gateway_id", respBody, nil)),
+		d.Set("transit_ip_id", utils.PathSearch("snat_rule.transit_ip_associations[0].transit_ip_id", respBody, nil)),
+		d.Set("transit_ip_address", utils.PathSearch("snat_rule.transit_ip_associations[0].transit_ip_address",
+			respBody, nil)),
+		d.Set("cidr", utils.PathSearch("snat_rule.cidr", respBody, nil)),
+		d.Set("subnet_id", utils.PathSearch("snat_rule.virsubnet_id", respBody, nil)),
+		d.Set("description", utils.PathSearch("snat_rule.description", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("snat_rule.enterprise_project_id", respBody, nil)),
+		d.Set("status", utils.PathSearch("snat_rule.status", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("snat_rule.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("snat_rule.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private SNAT rule (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourcePrivateSnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{rule_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{rule_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"snat_rule": map[string]interface{}{
+				"transit_ip_ids": []string{d.Get("transit_ip_id").(string)},
+				"description":    d.Get("description"),
+			},
+		},
+		OkCodes: []int{200},
+	}
+	_, err = client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating private SNAT rule (%s): %s", d.Id(), err)
+	}
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateSnatRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("nat", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v3 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{rule_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{rule_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private SNAT rule")
+	}
+	return nil
+}
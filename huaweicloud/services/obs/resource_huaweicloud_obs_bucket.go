@@ -33,6 +33,8 @@ func ResourceObsBucket() *schema.Resource {
 			StateContext: resourceObsBucketImport,
 		},
 
+		CustomizeDiff: resourceObsBucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -300,6 +302,34 @@ func ResourceObsBucket() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"object_lock_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"parallel_fs"},
+			},
+			"worm_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"object_lock_enabled"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 36500),
+							ExactlyOneOf: []string{"worm_policy.0.days", "worm_policy.0.years"},
+						},
+						"years": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
 			"encryption": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -356,7 +386,16 @@ func resourceObsBucketCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	log.Printf("[DEBUG] OBS bucket create opts: %#v", opts)
-	_, err = obsClient.CreateBucket(opts)
+	if d.Get("object_lock_enabled").(bool) {
+		// The WORM can only be enabled when creating the bucket.
+		obsClientWithSignature, err := conf.ObjectStorageClientWithSignature(region)
+		if err != nil {
+			return diag.Errorf("Error creating OBS client with signature: %s", err)
+		}
+		err = createObsBucketWithWorm(obsClientWithSignature, opts)
+	} else {
+		_, err = obsClient.CreateBucket(opts)
+	}
 	if err != nil {
 		return diag.FromErr(getObsError("Error creating bucket", bucket, err))
 	}
@@ -420,6 +459,16 @@ func resourceObsBucketUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if d.HasChange("worm_policy") {
+		obsClientWithV4Signature, err := conf.ObjectStorageClientWithV4Signature(region)
+		if err != nil {
+			return diag.Errorf("Error creating OBS client with V4 signature: %s", err)
+		}
+		if err := resourceObsBucketWormUpdate(obsClientWithV4Signature, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("logging") {
 		if err := resourceObsBucketLoggingUpdate(obsClient, d); err != nil {
 			return diag.FromErr(err)
@@ -523,6 +572,16 @@ func resourceObsBucketRead(_ context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	// Read the WORM configuration, the imported and existing buckets are refreshed as well, because the
+	// object_lock_enabled can only be set when creating the bucket.
+	obsClientWithV4Signature, err := conf.ObjectStorageClientWithV4Signature(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client with V4 signature: %s", err)
+	}
+	if err := setObsBucketWorm(obsClientWithV4Signature, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Read the logging configuration
 	if err := setObsBucketLogging(obsClient, d); err != nil {
 		return diag.FromErr(err)
@@ -586,6 +645,19 @@ func resourceObsBucketDelete(ctx context.Context, d *schema.ResourceData, meta i
 	_, err = obsClient.DeleteBucket(bucket)
 	if err != nil {
 		obsError, ok := err.(obs.ObsError)
+		if ok && obsError.Code == "BucketNotEmpty" && d.Get("object_lock_enabled").(bool) {
+			// All object versions of the WORM bucket must be deleted, but the protected versions can not be deleted
+			// before the retention expires.
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "OBS bucket with WORM enabled is not empty",
+					Detail: fmt.Sprintf("OBS bucket %s has WORM enabled and still contains object versions, the "+
+						"versions protected by the WORM retention can not be deleted before the retention expires, "+
+						"so the bucket can only be deleted after all object versions are removed.", bucket),
+				},
+			}
+		}
 		if ok && obsError.Code == "BucketNotEmpty" {
 			log.Printf("[WARN] OBS bucket: %s is not empty", bucket)
 			if d.Get("force_destroy").(bool) {
@@ -602,6 +674,14 @@ func resourceObsBucketDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceObsBucketCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// The versioning is enabled automatically and can not be suspended after the WORM is enabled.
+	if d.Get("object_lock_enabled").(bool) && !d.Get("versioning").(bool) {
+		return fmt.Errorf("versioning must be enabled when object_lock_enabled is true")
+	}
+	return nil
+}
+
 func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	tagMap := d.Get("tags").(map[string]interface{})
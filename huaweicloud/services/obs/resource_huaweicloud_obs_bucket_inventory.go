@@ -0,0 +1,238 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// obsInventoryConfiguration is the inventory configuration of the OBS bucket, which is not supported by the OBS SDK.
+type obsInventoryConfiguration struct {
+	XMLName                xml.Name `xml:"InventoryConfiguration"`
+	ID                     string   `xml:"Id"`
+	IsEnabled              bool     `xml:"IsEnabled"`
+	FilterPrefix           string   `xml:"Filter>Prefix,omitempty"`
+	DestinationFormat      string   `xml:"Destination>Format"`
+	DestinationBucket      string   `xml:"Destination>Bucket"`
+	DestinationPrefix      string   `xml:"Destination>Prefix,omitempty"`
+	Frequency              string   `xml:"Schedule>Frequency"`
+	IncludedObjectVersions string   `xml:"IncludedObjectVersions"`
+	OptionalFields         []string `xml:"OptionalFields>Field"`
+}
+
+// ResourceObsBucketInventory is the impl for huaweicloud_obs_bucket_inventory resource, which manages an inventory
+// configuration of the OBS bucket. The inventory lists the objects of the bucket and their metadata periodically.
+func ResourceObsBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketInventoryPut,
+		ReadContext:   resourceObsBucketInventoryRead,
+		UpdateContext: resourceObsBucketInventoryPut,
+		DeleteContext: resourceObsBucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketInventoryImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CSV",
+							ValidateFunc: validation.StringInSlice([]string{"CSV"}, false),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Current",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Size", "LastModifiedDate", "ETag", "StorageClass", "IsMultipartUploaded",
+						"ReplicationStatus", "EncryptionStatus",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func buildObsInventoryParams(name string) map[string]string {
+	return map[string]string{
+		"inventory": "",
+		"id":        name,
+	}
+}
+
+func resourceObsBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	// The inventory sub-resource is only signed by the V4 signature of the OBS SDK.
+	obsClient, err := conf.ObjectStorageClientWithV4Signature(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+	destination := d.Get("destination.0").(map[string]interface{})
+	configuration := obsInventoryConfiguration{
+		ID:                     name,
+		IsEnabled:              d.Get("enabled").(bool),
+		FilterPrefix:           d.Get("filter_prefix").(string),
+		DestinationFormat:      destination["format"].(string),
+		DestinationBucket:      destination["bucket"].(string),
+		DestinationPrefix:      destination["prefix"].(string),
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields:         utils.ExpandToStringList(d.Get("optional_fields").(*schema.Set).List()),
+	}
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] OBS bucket: %s, set inventory: %s", bucket, body)
+	_, err = doBucketSubResourceRequest(obsClient, obs.HttpMethodPut, bucket, buildObsInventoryParams(name), body)
+	if err != nil {
+		return diag.FromErr(getObsError("Error setting inventory configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, name))
+	return resourceObsBucketInventoryRead(ctx, d, meta)
+}
+
+func resourceObsBucketInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	obsClient, err := conf.ObjectStorageClientWithV4Signature(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+	body, err := doBucketSubResourceRequest(obsClient, obs.HttpMethodGet, bucket, buildObsInventoryParams(name), nil)
+	if err != nil {
+		return checkObsDeletedDiag(d, err, "Error getting inventory configuration of OBS bucket")
+	}
+
+	var configuration obsInventoryConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing inventory configuration of OBS bucket %s: %s", bucket, err)
+	}
+	log.Printf("[DEBUG] getting inventory configuration of OBS bucket %s: %#v", bucket, configuration)
+
+	destination := []map[string]interface{}{
+		{
+			"bucket": configuration.DestinationBucket,
+			"prefix": configuration.DestinationPrefix,
+			"format": configuration.DestinationFormat,
+		},
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("enabled", configuration.IsEnabled),
+		d.Set("filter_prefix", configuration.FilterPrefix),
+		d.Set("destination", destination),
+		d.Set("frequency", configuration.Frequency),
+		d.Set("included_object_versions", configuration.IncludedObjectVersions),
+		d.Set("optional_fields", configuration.OptionalFields),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving inventory configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketInventoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClientWithV4Signature(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] OBS bucket: %s, delete inventory: %s", bucket, name)
+	_, err = doBucketSubResourceRequest(obsClient, obs.HttpMethodDelete, bucket, buildObsInventoryParams(name), nil)
+	if err != nil {
+		return checkObsDeletedDiag(d, err, "Error deleting inventory configuration of OBS bucket")
+	}
+	return nil
+}
+
+func resourceObsBucketInventoryImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <bucket>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("bucket", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
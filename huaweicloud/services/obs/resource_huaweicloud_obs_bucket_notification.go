@@ -0,0 +1,239 @@
+package obs
+
+import (
+	"context"
+	"encoding/xml"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The OBS SDK only supports the notification to the SMN topics, so the notification configuration is defined here to
+// support both the SMN topics and the FunctionGraph functions.
+type obsNotificationFilterRule struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+type obsNotificationTarget struct {
+	ID            string                      `xml:"Id,omitempty"`
+	FilterRules   []obsNotificationFilterRule `xml:"Filter>Object>FilterRule"`
+	Topic         string                      `xml:"Topic,omitempty"`
+	FunctionStage string                      `xml:"FunctionStage,omitempty"`
+	Events        []string                    `xml:"Event"`
+}
+
+type obsNotificationConfiguration struct {
+	XMLName   xml.Name                `xml:"NotificationConfiguration"`
+	Topics    []obsNotificationTarget `xml:"TopicConfiguration"`
+	Functions []obsNotificationTarget `xml:"FunctionStageConfiguration"`
+}
+
+var obsNotificationEvents = []string{
+	"ObjectCreated:*", "ObjectCreated:Put", "ObjectCreated:Post", "ObjectCreated:Copy",
+	"ObjectCreated:CompleteMultipartUpload", "ObjectRemoved:*", "ObjectRemoved:Delete",
+	"ObjectRemoved:DeleteMarkerCreated",
+}
+
+func obsNotificationTargetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		AtLeastOneOf: []string{"topic", "function"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"urn": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"events": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(obsNotificationEvents, false),
+					},
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"suffix": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// ResourceObsBucketNotification is the impl for huaweicloud_obs_bucket_notification resource, which manages the event
+// notifications of the OBS bucket. The events can be sent to the SMN topics or trigger the FunctionGraph functions.
+func ResourceObsBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketNotificationPut,
+		ReadContext:   resourceObsBucketNotificationRead,
+		UpdateContext: resourceObsBucketNotificationPut,
+		DeleteContext: resourceObsBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic":    obsNotificationTargetSchema(),
+			"function": obsNotificationTargetSchema(),
+		},
+	}
+}
+
+func buildObsNotificationTargets(rawTargets []interface{}, isFunction bool) []obsNotificationTarget {
+	result := make([]obsNotificationTarget, 0, len(rawTargets))
+	for _, raw := range rawTargets {
+		target := raw.(map[string]interface{})
+		item := obsNotificationTarget{
+			ID:     target["id"].(string),
+			Events: utils.ExpandToStringList(target["events"].(*schema.Set).List()),
+		}
+		if isFunction {
+			item.FunctionStage = target["urn"].(string)
+		} else {
+			item.Topic = target["urn"].(string)
+		}
+		for _, name := range []string{"prefix", "suffix"} {
+			if v := target[name].(string); v != "" {
+				item.FilterRules = append(item.FilterRules, obsNotificationFilterRule{Name: name, Value: v})
+			}
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func putObsBucketNotification(obsClient *obs.ObsClient, bucket string, configuration obsNotificationConfiguration) error {
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] OBS bucket: %s, set notification: %s", bucket, body)
+	params := map[string]string{string(obs.SubResourceNotification): ""}
+	_, err = doBucketSubResourceRequest(obsClient, obs.HttpMethodPut, bucket, params, body)
+	return err
+}
+
+func resourceObsBucketNotificationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	// The FunctionGraph notification is only supported by the OBS signature.
+	obsClient, err := conf.ObjectStorageClientWithSignature(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	configuration := obsNotificationConfiguration{
+		Topics:    buildObsNotificationTargets(d.Get("topic").([]interface{}), false),
+		Functions: buildObsNotificationTargets(d.Get("function").([]interface{}), true),
+	}
+	if err = putObsBucketNotification(obsClient, bucket, configuration); err != nil {
+		return diag.FromErr(getObsError("Error setting notification configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketNotificationRead(ctx, d, meta)
+}
+
+func flattenObsNotificationTargets(targets []obsNotificationTarget) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(targets))
+	for _, target := range targets {
+		urn := target.Topic
+		if target.FunctionStage != "" {
+			urn = target.FunctionStage
+		}
+		item := map[string]interface{}{
+			"id":     target.ID,
+			"urn":    urn,
+			"events": target.Events,
+		}
+		for _, rule := range target.FilterRules {
+			item[strings.ToLower(rule.Name)] = rule.Value
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func resourceObsBucketNotificationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	obsClient, err := conf.ObjectStorageClientWithSignature(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	params := map[string]string{string(obs.SubResourceNotification): ""}
+	body, err := doBucketSubResourceRequest(obsClient, obs.HttpMethodGet, bucket, params, nil)
+	if err != nil {
+		return checkObsDeletedDiag(d, err, "Error getting notification configuration of OBS bucket")
+	}
+
+	var configuration obsNotificationConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return diag.Errorf("error parsing notification configuration of OBS bucket %s: %s", bucket, err)
+	}
+	log.Printf("[DEBUG] getting notification configuration of OBS bucket %s: %#v", bucket, configuration)
+	if len(configuration.Topics) == 0 && len(configuration.Functions) == 0 {
+		return checkObsDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", bucket),
+		d.Set("topic", flattenObsNotificationTargets(configuration.Topics)),
+		d.Set("function", flattenObsNotificationTargets(configuration.Functions)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving notification configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketNotificationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClientWithSignature(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	// An empty configuration removes all notifications of the bucket.
+	bucket := d.Id()
+	if err = putObsBucketNotification(obsClient, bucket, obsNotificationConfiguration{}); err != nil {
+		return checkObsDeletedDiag(d, err, "Error deleting notification configuration of OBS bucket")
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,7 +22,7 @@ func ResourceObsBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceObsBucketObjectPut,
 		ReadContext:   resourceObsBucketObjectRead,
-		UpdateContext: resourceObsBucketObjectPut,
+		UpdateContext: resourceObsBucketObjectUpdate,
 		DeleteContext: resourceObsBucketObjectDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceObsBucketObjectImport,
@@ -98,6 +99,22 @@ func ResourceObsBucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validation.StringInSlice([]string{wormModeCompliance}, false),
+			},
+
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRetainUntilDate,
+			},
+
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -171,6 +188,28 @@ func resourceObsBucketObjectPut(ctx context.Context, d *schema.ResourceData, met
 	}
 	d.SetId(key)
 
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		if err := resourceObsBucketObjectRetentionUpdate(conf, d, v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceObsBucketObjectRead(ctx, d, meta)
+}
+
+// resourceObsBucketObjectUpdate re-uploads the object only if the object content or attributes are changed, the
+// retention of the current object version is extended in place, otherwise the previous version stays locked as well.
+func resourceObsBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept("object_lock_mode", "object_lock_retain_until_date") {
+		return resourceObsBucketObjectPut(ctx, d, meta)
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok && d.HasChange("object_lock_retain_until_date") {
+		conf := meta.(*config.Config)
+		if err := resourceObsBucketObjectRetentionUpdate(conf, d, v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceObsBucketObjectRead(ctx, d, meta)
 }
 
@@ -238,6 +277,26 @@ func putFileToObject(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.Put
 	return obsClient.PutFile(putInput)
 }
 
+// resourceObsBucketObjectRetentionUpdate sets the WORM retention of the uploaded object version.
+func resourceObsBucketObjectRetentionUpdate(conf *config.Config, d *schema.ResourceData, retainUntilDate string) error {
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	retainUntil, err := time.Parse(time.RFC3339, retainUntilDate)
+	if err != nil {
+		return err
+	}
+
+	obsClient, err := conf.ObjectStorageClientWithV4Signature(conf.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OBS client with V4 signature: %s", err)
+	}
+	err = putObsObjectRetention(obsClient, bucket, key, d.Get("version_id").(string), retainUntil)
+	if err != nil {
+		return getObsError(fmt.Sprintf("Error setting retention of object %s in OBS bucket", key), bucket, err)
+	}
+	return nil
+}
+
 func resourceObsBucketObjectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conf := meta.(*config.Config)
 	region := conf.GetRegion(d)
@@ -274,8 +333,11 @@ func resourceObsBucketObjectRead(_ context.Context, d *schema.ResourceData, meta
 		class = normalizeStorageClass(class)
 	}
 
+	lockMode, retainUntil := flattenObsObjectRetention(objectMeta.ResponseHeaders)
 	mErr := multierror.Append(
 		d.Set("region", region),
+		d.Set("object_lock_mode", lockMode),
+		d.Set("object_lock_retain_until_date", retainUntil),
 		d.Set("storage_class", class),
 		d.Set("content_type", objectMeta.ContentType),
 		d.Set("version_id", objectMeta.VersionId),
@@ -304,10 +366,17 @@ func resourceObsBucketObjectDelete(_ context.Context, d *schema.ResourceData, me
 		Key:    key,
 	}
 
+	// Deleting the object without the version ID only adds a delete marker in the WORM bucket, so the version is
+	// specified to delete the protected data.
+	retainUntil := d.Get("object_lock_retain_until_date").(string)
+	if retainUntil != "" {
+		input.VersionId = d.Get("version_id").(string)
+	}
+
 	log.Printf("[DEBUG] Object %s will be deleted with all versions", key)
 	_, err = obsClient.DeleteObject(input)
 	if err != nil {
-		return diag.FromErr(getObsError("Error deleting object of OBS bucket", bucket, err))
+		return buildObsRetentionDeleteDiag(err, bucket, key, d.Get("object_lock_mode").(string), retainUntil)
 	}
 
 	return nil
@@ -0,0 +1,590 @@
+package obs
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const (
+	// The user metadata which records the MD5 of the local file, because the ETag of the object uploaded by the
+	// multipart upload is not the MD5 of the content.
+	objectsSyncMD5MetaKey = "md5"
+	// The max number of the objects in one batch delete request.
+	objectsSyncDeleteBatchSize = 1000
+)
+
+// ResourceObsBucketObjectsSync is the impl for huaweicloud_obs_bucket_objects_sync resource, which synchronizes the
+// files of a local directory to the OBS bucket. Only the changed files are uploaded, and the large files are uploaded
+// by the concurrent multipart upload.
+func ResourceObsBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketObjectsSyncCreate,
+		ReadContext:   resourceObsBucketObjectsSyncRead,
+		UpdateContext: resourceObsBucketObjectsSyncUpdate,
+		DeleteContext: resourceObsBucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceObsBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"delete_remote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"private", "public-read", "public-read-write",
+				}, true),
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "WARM", "COLD",
+				}, true),
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 5120),
+			},
+			"parallel": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"header_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glob": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// objectsSyncFile is a local file to be uploaded.
+type objectsSyncFile struct {
+	path    string
+	relPath string
+	size    int64
+	md5     string
+}
+
+func buildObjectsSyncKey(prefix, relPath string) string {
+	return prefix + relPath
+}
+
+// scanObjectsSyncDir walks the source directory and returns the local files keyed by the object key.
+func scanObjectsSyncDir(sourceDir, prefix string) (map[string]objectsSyncFile, error) {
+	files := make(map[string]objectsSyncFile)
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		hash, err := computeFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		relPath = filepath.ToSlash(relPath)
+		files[buildObjectsSyncKey(prefix, relPath)] = objectsSyncFile{
+			path:    filePath,
+			relPath: relPath,
+			size:    info.Size(),
+			md5:     hash,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error scanning the source directory %s: %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+func computeFileMD5(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func flattenObjectsSyncFiles(files map[string]objectsSyncFile) map[string]interface{} {
+	result := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		result[key] = file.md5
+	}
+	return result
+}
+
+// objectsSyncHeaders is the headers and the user metadata of an object.
+type objectsSyncHeaders struct {
+	contentType        string
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	metadata           map[string]string
+}
+
+// matchObjectsSyncHeaderRule returns the headers of the first rule which matches the relative path of the file.
+// A glob without the slash is also matched against the file name.
+func matchObjectsSyncHeaderRule(rules []interface{}, relPath string) objectsSyncHeaders {
+	var headers objectsSyncHeaders
+	for _, raw := range rules {
+		rule := raw.(map[string]interface{})
+		glob := rule["glob"].(string)
+		matched, _ := path.Match(glob, relPath)
+		if !matched && !strings.Contains(glob, "/") {
+			matched, _ = path.Match(glob, path.Base(relPath))
+		}
+		if !matched {
+			continue
+		}
+
+		headers = objectsSyncHeaders{
+			contentType:        rule["content_type"].(string),
+			cacheControl:       rule["cache_control"].(string),
+			contentDisposition: rule["content_disposition"].(string),
+			contentEncoding:    rule["content_encoding"].(string),
+			metadata:           make(map[string]string),
+		}
+		for k, v := range rule["metadata"].(map[string]interface{}) {
+			headers.metadata[k] = v.(string)
+		}
+		break
+	}
+
+	if headers.contentType == "" {
+		headers.contentType = mime.TypeByExtension(path.Ext(relPath))
+	}
+	return headers
+}
+
+// objectsSyncUploadOpts is the upload options shared by all files, which is read from the resource data before the
+// concurrent uploads.
+type objectsSyncUploadOpts struct {
+	bucket       string
+	acl          obs.AclType
+	storageClass obs.StorageClassType
+	partSize     int64
+	parallel     int
+	headerRules  []interface{}
+}
+
+func buildObjectsSyncUploadOpts(d *schema.ResourceData) objectsSyncUploadOpts {
+	return objectsSyncUploadOpts{
+		bucket:       d.Get("bucket").(string),
+		acl:          obs.AclType(d.Get("acl").(string)),
+		storageClass: obs.StorageClassType(strings.ToUpper(d.Get("storage_class").(string))),
+		partSize:     int64(d.Get("part_size").(int)) * 1024 * 1024,
+		parallel:     d.Get("parallel").(int),
+		headerRules:  d.Get("header_rule").([]interface{}),
+	}
+}
+
+func uploadObjectsSyncFile(obsClient *obs.ObsClient, opts objectsSyncUploadOpts, key string,
+	file objectsSyncFile) error {
+	bucket := opts.bucket
+	headers := matchObjectsSyncHeaderRule(opts.headerRules, file.relPath)
+	metadata := map[string]string{objectsSyncMD5MetaKey: file.md5}
+	for k, v := range headers.metadata {
+		metadata[k] = v
+	}
+
+	operation := obs.ObjectOperationInput{
+		Bucket:       bucket,
+		Key:          key,
+		ACL:          opts.acl,
+		StorageClass: opts.storageClass,
+		Metadata:     metadata,
+	}
+
+	var err error
+	if file.size > opts.partSize {
+		log.Printf("[DEBUG] uploading %s to OBS bucket %s by the multipart upload", key, bucket)
+		_, err = obsClient.UploadFile(&obs.UploadFileInput{
+			ObjectOperationInput: operation,
+			ContentType:          headers.contentType,
+			UploadFile:           file.path,
+			PartSize:             opts.partSize,
+			TaskNum:              opts.parallel,
+		})
+	} else {
+		log.Printf("[DEBUG] uploading %s to OBS bucket %s", key, bucket)
+		input := &obs.PutFileInput{}
+		input.ObjectOperationInput = operation
+		input.ContentType = headers.contentType
+		input.SourceFile = file.path
+		_, err = obsClient.PutFile(input)
+	}
+	if err != nil {
+		return getObsError(fmt.Sprintf("Error uploading object %s to OBS bucket", key), bucket, err)
+	}
+
+	if headers.cacheControl == "" && headers.contentDisposition == "" && headers.contentEncoding == "" {
+		return nil
+	}
+	// The upload APIs can not set the cache headers, so replace the metadata of the uploaded object.
+	_, err = obsClient.SetObjectMetadata(&obs.SetObjectMetadataInput{
+		Bucket:             bucket,
+		Key:                key,
+		MetadataDirective:  obs.ReplaceNew,
+		ContentType:        headers.contentType,
+		CacheControl:       headers.cacheControl,
+		ContentDisposition: headers.contentDisposition,
+		ContentEncoding:    headers.contentEncoding,
+		StorageClass:       opts.storageClass,
+		Metadata:           metadata,
+	})
+	if err != nil {
+		return getObsError(fmt.Sprintf("Error setting metadata of object %s in OBS bucket", key), bucket, err)
+	}
+	return nil
+}
+
+// uploadObjectsSyncFiles uploads the files concurrently, the number of the concurrent uploads is limited by parallel.
+func uploadObjectsSyncFiles(obsClient *obs.ObsClient, d *schema.ResourceData, files map[string]objectsSyncFile) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		mErr *multierror.Error
+	)
+
+	opts := buildObjectsSyncUploadOpts(d)
+	keys := make(chan string)
+	for i := 0; i < opts.parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				if err := uploadObjectsSyncFile(obsClient, opts, key, files[key]); err != nil {
+					mu.Lock()
+					mErr = multierror.Append(mErr, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for key := range files {
+		keys <- key
+	}
+	close(keys)
+	wg.Wait()
+
+	return mErr.ErrorOrNil()
+}
+
+func deleteObjectsSyncObjects(obsClient *obs.ObsClient, bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += objectsSyncDeleteBatchSize {
+		end := start + objectsSyncDeleteBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		objects := make([]obs.ObjectToDelete, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, obs.ObjectToDelete{Key: key})
+		}
+		log.Printf("[DEBUG] deleting %d objects from OBS bucket %s", len(objects), bucket)
+		output, err := obsClient.DeleteObjects(&obs.DeleteObjectsInput{
+			Bucket:  bucket,
+			Quiet:   true,
+			Objects: objects,
+		})
+		if err != nil {
+			return getObsError("Error deleting objects of OBS bucket", bucket, err)
+		}
+		if len(output.Errors) > 0 {
+			return fmt.Errorf("error deleting object %s of OBS bucket %s: %s", output.Errors[0].Key, bucket,
+				output.Errors[0].Message)
+		}
+	}
+	return nil
+}
+
+// listObjectsSyncRemote returns the MD5 of the remote objects under the key prefix.
+// The ETag is used if the object is uploaded by a single request, otherwise the MD5 is read from the user metadata.
+func listObjectsSyncRemote(obsClient *obs.ObsClient, bucket, prefix string, filter func(string) bool) (
+	map[string]string, error) {
+	result := make(map[string]string)
+	input := &obs.ListObjectsInput{
+		Bucket: bucket,
+	}
+	input.Prefix = prefix
+	for {
+		output, err := obsClient.ListObjects(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, content := range output.Contents {
+			if strings.HasSuffix(content.Key, "/") || !filter(content.Key) {
+				continue
+			}
+
+			etag := strings.Trim(content.ETag, "\"")
+			if !strings.Contains(etag, "-") {
+				result[content.Key] = etag
+				continue
+			}
+			metaOutput, err := obsClient.GetObjectMetadata(&obs.GetObjectMetadataInput{
+				Bucket: bucket,
+				Key:    content.Key,
+			})
+			if err != nil {
+				return nil, err
+			}
+			result[content.Key] = metaOutput.Metadata[objectsSyncMD5MetaKey]
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		input.Marker = output.NextMarker
+	}
+	return result, nil
+}
+
+func resourceObsBucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := scanObjectsSyncDir(d.Get("source_dir").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	local := flattenObjectsSyncFiles(files)
+	old := d.Get("files").(map[string]interface{})
+	if len(local) != len(old) || d.HasChanges("header_rule", "acl", "storage_class") {
+		return d.SetNew("files", local)
+	}
+	for key, hash := range local {
+		if old[key] != hash {
+			return d.SetNew("files", local)
+		}
+	}
+	return nil
+}
+
+func resourceObsBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("key_prefix").(string)
+	files, err := scanObjectsSyncDir(d.Get("source_dir").(string), prefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = uploadObjectsSyncFiles(obsClient, d, files); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", bucket, prefix))
+
+	if d.Get("delete_remote").(bool) {
+		remote, err := listObjectsSyncRemote(obsClient, bucket, prefix, func(key string) bool {
+			_, ok := files[key]
+			return !ok
+		})
+		if err != nil {
+			return diag.FromErr(getObsError("Error listing objects of OBS bucket", bucket, err))
+		}
+		keys := make([]string, 0, len(remote))
+		for key := range remote {
+			keys = append(keys, key)
+		}
+		if err = deleteObjectsSyncObjects(obsClient, bucket, keys); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceObsBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceObsBucketObjectsSyncRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	obsClient, err := conf.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	// Only the objects managed by the resource are refreshed, all objects under the key prefix are refreshed if the
+	// remote objects not present locally should be deleted.
+	bucket := d.Get("bucket").(string)
+	managed := d.Get("files").(map[string]interface{})
+	deleteRemote := d.Get("delete_remote").(bool)
+	remote, err := listObjectsSyncRemote(obsClient, bucket, d.Get("key_prefix").(string), func(key string) bool {
+		_, ok := managed[key]
+		return ok || deleteRemote
+	})
+	if err != nil {
+		return checkObsDeletedDiag(d, err, "Error listing objects of OBS bucket")
+	}
+	log.Printf("[DEBUG] found %d objects in OBS bucket %s", len(remote), bucket)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("files", remote),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving objects sync of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	files, err := scanObjectsSyncDir(d.Get("source_dir").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldRaw, _ := d.GetChange("files")
+	remote := oldRaw.(map[string]interface{})
+	// All files are uploaded again if the headers, ACL or storage class are changed.
+	uploadAll := d.HasChanges("header_rule", "acl", "storage_class")
+	changed := make(map[string]objectsSyncFile)
+	for key, file := range files {
+		if uploadAll || remote[key] != file.md5 {
+			changed[key] = file
+		}
+	}
+	if err = uploadObjectsSyncFiles(obsClient, d, changed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("delete_remote").(bool) {
+		keys := make([]string, 0)
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		if err = deleteObjectsSyncObjects(obsClient, bucket, keys); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceObsBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceObsBucketObjectsSyncDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	files := d.Get("files").(map[string]interface{})
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	if err = deleteObjectsSyncObjects(obsClient, d.Get("bucket").(string), keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
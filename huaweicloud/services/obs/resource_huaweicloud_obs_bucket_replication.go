@@ -0,0 +1,209 @@
+package obs
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceObsBucketReplication is the impl for huaweicloud_obs_bucket_replication resource, which manages the
+// cross-region replication configuration of the OBS bucket.
+func ResourceObsBucketReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketReplicationPut,
+		ReadContext:   resourceObsBucketReplicationRead,
+		UpdateContext: resourceObsBucketReplicationPut,
+		DeleteContext: resourceObsBucketReplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"agency": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"STANDARD", "WARM", "COLD",
+							}, false),
+						},
+						"delete_data": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"history_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildEnabledType(enabled bool) obs.EnabledType {
+	if enabled {
+		return obs.Enabled
+	}
+	return obs.Disabled
+}
+
+func buildObsBucketReplicationRules(d *schema.ResourceData) []obs.ReplicationRule {
+	destination := d.Get("destination_bucket").(string)
+	rawRules := d.Get("rule").([]interface{})
+	rules := make([]obs.ReplicationRule, 0, len(rawRules))
+	for _, raw := range rawRules {
+		rule := raw.(map[string]interface{})
+		status := obs.RuleStatusDisabled
+		if rule["enabled"].(bool) {
+			status = obs.RuleStatusEnabled
+		}
+		rules = append(rules, obs.ReplicationRule{
+			ID:                          rule["id"].(string),
+			Prefix:                      rule["prefix"].(string),
+			Status:                      status,
+			DestinationBucket:           destination,
+			StorageClass:                obs.StorageClassType(rule["storage_class"].(string)),
+			DeleteDate:                  buildEnabledType(rule["delete_data"].(bool)),
+			HistoricalObjectReplication: buildEnabledType(rule["history_enabled"].(bool)),
+		})
+	}
+	return rules
+}
+
+func resourceObsBucketReplicationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetBucketReplicationInput{
+		Bucket: bucket,
+		BucketReplicationConfiguration: obs.BucketReplicationConfiguration{
+			Agency:           d.Get("agency").(string),
+			ReplicationRules: buildObsBucketReplicationRules(d),
+		},
+	}
+	log.Printf("[DEBUG] OBS bucket: %s, set replication: %#v", bucket, input.BucketReplicationConfiguration)
+	if _, err = obsClient.SetBucketReplication(input); err != nil {
+		return diag.FromErr(getObsError("Error setting replication configuration of OBS bucket", bucket, err))
+	}
+
+	d.SetId(bucket)
+	return resourceObsBucketReplicationRead(ctx, d, meta)
+}
+
+func flattenObsBucketReplicationRules(rules []obs.ReplicationRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"id":              rule.ID,
+			"prefix":          rule.Prefix,
+			"enabled":         rule.Status == obs.RuleStatusEnabled,
+			"storage_class":   normalizeStorageClass(string(rule.StorageClass)),
+			"delete_data":     rule.DeleteDate == obs.Enabled,
+			"history_enabled": rule.HistoricalObjectReplication == obs.Enabled,
+		})
+	}
+	return result
+}
+
+func resourceObsBucketReplicationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	obsClient, err := conf.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	output, err := obsClient.GetBucketReplication(bucket)
+	if err != nil {
+		return checkObsDeletedDiag(d, err, "Error getting replication configuration of OBS bucket")
+	}
+	log.Printf("[DEBUG] getting replication configuration of OBS bucket %s: %#v", bucket, output)
+
+	var destination string
+	if len(output.ReplicationRules) > 0 {
+		destination = output.ReplicationRules[0].DestinationBucket
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", bucket),
+		d.Set("agency", output.Agency),
+		d.Set("destination_bucket", destination),
+		d.Set("rule", flattenObsBucketReplicationRules(output.ReplicationRules)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving replication configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketReplicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(conf.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("Error creating OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	log.Printf("[DEBUG] OBS bucket: %s, delete replication", bucket)
+	if _, err = obsClient.DeleteBucketReplication(bucket); err != nil {
+		return checkObsDeletedDiag(d, err, "Error deleting replication configuration of OBS bucket")
+	}
+	return nil
+}
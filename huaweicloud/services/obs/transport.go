@@ -0,0 +1,73 @@
+package obs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+)
+
+// doBucketSubResourceRequest sends the request of the bucket sub-resource which has no model in the OBS SDK, such as
+// the FunctionGraph notification and the inventory. The request is signed by the SDK client and sent with the signed
+// URL, so the error of the response is still parsed into obs.ObsError.
+// The response body is returned for the GET request.
+func doBucketSubResourceRequest(obsClient *obs.ObsClient, method obs.HttpMethodType, bucket string,
+	params map[string]string, body []byte) ([]byte, error) {
+	return doObsSignedRequest(obsClient, &obs.CreateSignedUrlInput{
+		Method:      method,
+		Bucket:      bucket,
+		QueryParams: params,
+	}, body)
+}
+
+// doObsSignedRequest sends the request described by the input with the signed URL, the headers of the input are
+// signed together, so it can be used to send the headers which are not supported by the OBS SDK.
+func doObsSignedRequest(obsClient *obs.ObsClient, input *obs.CreateSignedUrlInput, body []byte) ([]byte, error) {
+	method := input.Method
+	if body != nil {
+		if input.Headers == nil {
+			input.Headers = make(map[string]string)
+		}
+		input.Headers["Content-MD5"] = obs.Base64Md5(body)
+		input.Headers["Content-Type"] = "application/xml"
+	}
+
+	signed, err := obsClient.CreateSignedUrl(input)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method {
+	case obs.HttpMethodGet:
+		output, err := obsClient.GetObjectWithSignedUrl(signed.SignedUrl, signed.ActualSignedRequestHeaders)
+		if err != nil {
+			return nil, err
+		}
+		defer output.Body.Close()
+		return io.ReadAll(output.Body)
+	case obs.HttpMethodPut:
+		_, err = obsClient.PutObjectWithSignedUrl(signed.SignedUrl, signed.ActualSignedRequestHeaders,
+			bytes.NewReader(body))
+	case obs.HttpMethodDelete:
+		_, err = obsClient.DeleteObjectWithSignedUrl(signed.SignedUrl, signed.ActualSignedRequestHeaders)
+	default:
+		err = fmt.Errorf("unsupported HTTP method: %s", method)
+	}
+	return nil, err
+}
+
+// checkObsDeletedDiag works like common.CheckDeletedDiag, and it treats the OBS error with status code 404 as the
+// bucket or its sub-resource is not found.
+func checkObsDeletedDiag(d *schema.ResourceData, err error, msg string) diag.Diagnostics {
+	if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+		err = golangsdk.ErrDefault404{}
+	}
+	return common.CheckDeletedDiag(d, err, msg)
+}
@@ -0,0 +1,249 @@
+package obs
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+)
+
+// The OBS SDK does not support the WORM (object lock) APIs, so the requests are sent with the signed URL.
+// The "object-lock" and "retention" sub-resources are not signed by the V2 and OBS signatures of the SDK, so the client
+// with the V4 signature is required to manage them.
+
+const (
+	wormModeCompliance = "COMPLIANCE"
+
+	wormHeaderBucketEnabled = "x-obs-bucket-object-lock-enabled"
+	// The response headers without the "x-obs-" prefix.
+	wormHeaderObjectMode        = "object-lock-mode"
+	wormHeaderObjectRetainUntil = "object-lock-retain-until-date"
+)
+
+type obsWormDefaultRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+type obsWormConfiguration struct {
+	XMLName           xml.Name                 `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string                   `xml:"ObjectLockEnabled"`
+	DefaultRetention  *obsWormDefaultRetention `xml:"Rule>DefaultRetention,omitempty"`
+}
+
+type obsWormRetention struct {
+	XMLName         xml.Name `xml:"Retention"`
+	Mode            string   `xml:"Mode"`
+	RetainUntilDate int64    `xml:"RetainUntilDate"`
+}
+
+type obsCreateBucketConfiguration struct {
+	XMLName  xml.Name `xml:"CreateBucketConfiguration"`
+	Location string   `xml:"Location"`
+}
+
+// createObsBucketWithWorm creates the bucket with the WORM enabled, the obsClient must use the OBS signature.
+// The SDK can not send the WORM header of the bucket creation, so the headers of the CreateBucketInput are built here.
+func createObsBucketWithWorm(obsClient *obs.ObsClient, opts *obs.CreateBucketInput) error {
+	headers := map[string]string{
+		wormHeaderBucketEnabled: "true",
+	}
+	if opts.ACL != "" {
+		headers["x-obs-acl"] = string(opts.ACL)
+	}
+	if opts.StorageClass != "" {
+		headers["x-obs-storage-class"] = string(opts.StorageClass)
+	}
+	if opts.Epid != "" {
+		headers["x-obs-epid"] = opts.Epid
+	}
+	if opts.AvailableZone != "" {
+		headers["x-obs-az-redundancy"] = opts.AvailableZone
+	}
+
+	body, err := xml.Marshal(obsCreateBucketConfiguration{Location: opts.Location})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] creating OBS bucket %s with WORM enabled, headers: %#v", opts.Bucket, headers)
+	_, err = doObsSignedRequest(obsClient, &obs.CreateSignedUrlInput{
+		Method:  obs.HttpMethodPut,
+		Bucket:  opts.Bucket,
+		Headers: headers,
+	}, body)
+	return err
+}
+
+func buildObsWormConfiguration(d *schema.ResourceData) obsWormConfiguration {
+	configuration := obsWormConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	if rawPolicies := d.Get("worm_policy").([]interface{}); len(rawPolicies) > 0 && rawPolicies[0] != nil {
+		policy := rawPolicies[0].(map[string]interface{})
+		configuration.DefaultRetention = &obsWormDefaultRetention{
+			Mode:  wormModeCompliance,
+			Days:  policy["days"].(int),
+			Years: policy["years"].(int),
+		}
+	}
+	return configuration
+}
+
+// resourceObsBucketWormUpdate sets the default retention of the bucket, the default retention is removed if the
+// worm_policy is not specified.
+func resourceObsBucketWormUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	body, err := xml.Marshal(buildObsWormConfiguration(d))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] OBS bucket: %s, set WORM configuration: %s", bucket, body)
+	params := map[string]string{"object-lock": ""}
+	if _, err = doBucketSubResourceRequest(obsClient, obs.HttpMethodPut, bucket, params, body); err != nil {
+		return getObsError("Error setting WORM configuration of OBS bucket", bucket, err)
+	}
+	return nil
+}
+
+func setObsBucketWorm(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Id()
+	params := map[string]string{"object-lock": ""}
+	body, err := doBucketSubResourceRequest(obsClient, obs.HttpMethodGet, bucket, params, nil)
+	if err != nil {
+		obsError, ok := err.(obs.ObsError)
+		// The WORM configuration can not be queried in some regions or with the restricted bucket policies, the
+		// configuration in the state is kept because the WORM can not be disabled once enabled.
+		if ok && (obsError.StatusCode == 403 || obsError.StatusCode == 405) {
+			log.Printf("[WARN] unable to get WORM configuration of OBS bucket %s: %s", bucket, err)
+			return nil
+		}
+		if ok && (obsError.StatusCode == 404 || obsError.Code == "FsNotSupport") {
+			if err = d.Set("object_lock_enabled", false); err != nil {
+				return fmt.Errorf("error saving WORM configuration of OBS bucket %s: %s", bucket, err)
+			}
+			return d.Set("worm_policy", nil)
+		}
+		return getObsError("Error getting WORM configuration of OBS bucket", bucket, err)
+	}
+
+	var configuration obsWormConfiguration
+	if err = xml.Unmarshal(body, &configuration); err != nil {
+		return fmt.Errorf("error parsing WORM configuration of OBS bucket %s: %s", bucket, err)
+	}
+	log.Printf("[DEBUG] getting WORM configuration of OBS bucket %s: %#v", bucket, configuration)
+
+	var policies []map[string]interface{}
+	if retention := configuration.DefaultRetention; retention != nil && (retention.Days > 0 || retention.Years > 0) {
+		policies = []map[string]interface{}{
+			{
+				"days":  retention.Days,
+				"years": retention.Years,
+			},
+		}
+	}
+	if err = d.Set("object_lock_enabled", configuration.ObjectLockEnabled == "Enabled"); err != nil {
+		return fmt.Errorf("error saving WORM configuration of OBS bucket %s: %s", bucket, err)
+	}
+	if err = d.Set("worm_policy", policies); err != nil {
+		return fmt.Errorf("error saving WORM configuration of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+// putObsObjectRetention sets the retention of the object version, the retention period can only be extended.
+func putObsObjectRetention(obsClient *obs.ObsClient, bucket, key, versionId string, retainUntil time.Time) error {
+	body, err := xml.Marshal(obsWormRetention{
+		Mode:            wormModeCompliance,
+		RetainUntilDate: retainUntil.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{"retention": ""}
+	if versionId != "" {
+		params["versionId"] = versionId
+	}
+	log.Printf("[DEBUG] OBS bucket: %s, set retention of object %s: %s", bucket, key, body)
+	_, err = doObsSignedRequest(obsClient, &obs.CreateSignedUrlInput{
+		Method:      obs.HttpMethodPut,
+		Bucket:      bucket,
+		Key:         key,
+		QueryParams: params,
+	}, body)
+	return err
+}
+
+// parseObsRetainUntilDate parses the retain until date returned by OBS, which is a timestamp in milliseconds or a
+// date in the HTTP format.
+func parseObsRetainUntilDate(value string) (time.Time, error) {
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.RFC1123} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid retain until date: %s", value)
+}
+
+// flattenObsObjectRetention returns the retention mode and the retain until date in RFC3339 format of the object.
+func flattenObsObjectRetention(headers map[string][]string) (mode, retainUntil string) {
+	if v, ok := headers[wormHeaderObjectMode]; ok && len(v) > 0 {
+		mode = v[0]
+	}
+	if v, ok := headers[wormHeaderObjectRetainUntil]; ok && len(v) > 0 {
+		if t, err := parseObsRetainUntilDate(v[0]); err == nil {
+			retainUntil = t.Format(time.RFC3339)
+		} else {
+			log.Printf("[WARN] %s", err)
+		}
+	}
+	return
+}
+
+func suppressEquivalentRetainUntilDate(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// buildObsRetentionDeleteDiag returns a clear diagnostic if the deletion is rejected by the WORM retention of the object,
+// otherwise the generic OBS error is returned.
+func buildObsRetentionDeleteDiag(err error, bucket, key, mode, retainUntil string) diag.Diagnostics {
+	obsError, ok := err.(obs.ObsError)
+	if !ok || obsError.StatusCode != 403 || retainUntil == "" {
+		return diag.FromErr(getObsError("Error deleting object of OBS bucket", bucket, err))
+	}
+	if t, parseErr := time.Parse(time.RFC3339, retainUntil); parseErr != nil || t.Before(time.Now()) {
+		return diag.FromErr(getObsError("Error deleting object of OBS bucket", bucket, err))
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Object is protected by the WORM retention",
+			Detail: fmt.Sprintf("object %s in bucket %s is retained in %s mode until %s, and it can not be deleted "+
+				"before the retention expires.\nThe retention can not be shortened or removed, use "+
+				"`terraform state rm` if the object should no longer be managed by Terraform.\nReason: %s",
+				key, bucket, strings.ToUpper(mode), retainUntil, obsError.Message),
+		},
+	}
+}
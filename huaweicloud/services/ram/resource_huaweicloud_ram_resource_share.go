@@ -0,0 +1,357 @@
+package ram
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceShare is used to share the resources (such as ER instances) of the current account with other accounts.
+func ResourceShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceShareCreate,
+		ReadContext:   resourceShareRead,
+		UpdateContext: resourceShareUpdate,
+		DeleteContext: resourceShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  `The name of the resource share.`,
+			},
+			"principals": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The list of account IDs (or organization paths) that the resources are shared with.`,
+			},
+			"resource_urns": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The list of URNs of the resources to be shared.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the resource share.`,
+			},
+			"permission_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The list of RAM permission IDs associated with the resource share.`,
+			},
+			"owning_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the account that owns the resource share.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the resource share.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the resource share.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the resource share.`,
+			},
+		},
+	}
+}
+
+func buildResourceShareCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":          d.Get("name"),
+		"description":   utils.ValueIngoreEmpty(d.Get("description")),
+		"principals":    utils.ExpandToStringListBySet(d.Get("principals").(*schema.Set)),
+		"resource_urns": utils.ExpandToStringListBySet(d.Get("resource_urns").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("permission_ids"); ok {
+		bodyParams["permission_ids"] = utils.ExpandToStringListBySet(v.(*schema.Set))
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+func resourceShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v1/resource-shares"
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildResourceShareCreateBodyParams(d),
+		OkCodes:          []int{200, 201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating resource share: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shareId := utils.PathSearch("resource_share.id", respBody, "").(string)
+	if shareId == "" {
+		return diag.Errorf("unable to find the resource share ID from the API response")
+	}
+	d.SetId(shareId)
+
+	return resourceShareRead(ctx, d, meta)
+}
+
+// GetResourceShare is a method to query the resource share owned by the current account.
+func GetResourceShare(client *golangsdk.ServiceClient, shareId string) (interface{}, error) {
+	searchPath := client.Endpoint + "v1/resource-shares/search"
+	searchOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"resource_share_ids": []string{shareId},
+			"resource_owner":     "self",
+		},
+		OkCodes: []int{200},
+	}
+	resp, err := client.Request("POST", searchPath, &searchOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	share := utils.PathSearch("resource_shares[0]", respBody, nil)
+	// The deleted resource share can still be queried for a while.
+	if share == nil || utils.PathSearch("status", share, "").(string) == "deleted" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return share, nil
+}
+
+func queryResourceShareAssociations(client *golangsdk.ServiceClient, shareId, associationType string) ([]string, error) {
+	searchPath := client.Endpoint + "v1/resource-share-associations/search"
+	bodyParams := map[string]interface{}{
+		"association_type":   associationType,
+		"resource_share_ids": []string{shareId},
+		"limit":              200,
+	}
+
+	result := make([]string, 0)
+	for {
+		searchOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         bodyParams,
+			OkCodes:          []int{200},
+		}
+		resp, err := client.Request("POST", searchPath, &searchOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		associations := utils.PathSearch("resource_share_associations", respBody,
+			make([]interface{}, 0)).([]interface{})
+		for _, association := range associations {
+			status := utils.PathSearch("status", association, "").(string)
+			if status == "associated" || status == "associating" {
+				result = append(result, utils.PathSearch("associated_entity", association, "").(string))
+			}
+		}
+
+		marker := utils.PathSearch("page_info.next_marker", respBody, "").(string)
+		if marker == "" {
+			break
+		}
+		bodyParams["marker"] = marker
+	}
+	return result, nil
+}
+
+func queryResourceSharePermissions(client *golangsdk.ServiceClient, shareId string) ([]interface{}, error) {
+	listPath := client.Endpoint + "v1/resource-shares/{resource_share_id}/associated-permissions"
+	listPath = strings.ReplaceAll(listPath, "{resource_share_id}", shareId)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("associated_permissions[*].permission_id", respBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+func resourceShareRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	share, err := GetResourceShare(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "RAM resource share")
+	}
+
+	principals, err := queryResourceShareAssociations(client, d.Id(), "principal")
+	if err != nil {
+		return diag.Errorf("error querying principals of the resource share (%s): %s", d.Id(), err)
+	}
+	resourceUrns, err := queryResourceShareAssociations(client, d.Id(), "resource")
+	if err != nil {
+		return diag.Errorf("error querying resources of the resource share (%s): %s", d.Id(), err)
+	}
+	permissionIds, err := queryResourceSharePermissions(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error querying permissions of the resource share (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", utils.PathSearch("name", share, nil)),
+		d.Set("description", utils.PathSearch("description", share, nil)),
+		d.Set("principals", principals),
+		d.Set("resource_urns", resourceUrns),
+		d.Set("permission_ids", permissionIds),
+		d.Set("owning_account_id", utils.PathSearch("owning_account_id", share, nil)),
+		d.Set("status", utils.PathSearch("status", share, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", share, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", share, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving resource share (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func updateResourceShareAssociations(client *golangsdk.ServiceClient, shareId, action string,
+	principals, resourceUrns []string) error {
+	if len(principals) < 1 && len(resourceUrns) < 1 {
+		return nil
+	}
+
+	actionPath := client.Endpoint + "v1/resource-shares/{resource_share_id}/{action}"
+	actionPath = strings.ReplaceAll(actionPath, "{resource_share_id}", shareId)
+	actionPath = strings.ReplaceAll(actionPath, "{action}", action)
+	bodyParams := make(map[string]interface{})
+	if len(principals) > 0 {
+		bodyParams["principals"] = principals
+	}
+	if len(resourceUrns) > 0 {
+		bodyParams["resource_urns"] = resourceUrns
+	}
+	actionOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         bodyParams,
+		OkCodes:          []int{200},
+	}
+	_, err := client.Request("POST", actionPath, &actionOpt)
+	if err != nil {
+		return fmt.Errorf("error trying to %s the principals and resources of the resource share (%s): %s",
+			action, shareId, err)
+	}
+	return nil
+}
+
+func resourceShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updatePath := client.Endpoint + "v1/resource-shares/{resource_share_id}"
+		updatePath = strings.ReplaceAll(updatePath, "{resource_share_id}", d.Id())
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"name":        d.Get("name"),
+				"description": d.Get("description"),
+			},
+			OkCodes: []int{200},
+		}
+		_, err = client.Request("PUT", updatePath, &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating resource share (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("principals", "resource_urns") {
+		oldPrincipals, newPrincipals := d.GetChange("principals")
+		oldResources, newResources := d.GetChange("resource_urns")
+		var (
+			oldPrincipalSet = oldPrincipals.(*schema.Set)
+			newPrincipalSet = newPrincipals.(*schema.Set)
+			oldResourceSet  = oldResources.(*schema.Set)
+			newResourceSet  = newResources.(*schema.Set)
+		)
+
+		err = updateResourceShareAssociations(client, d.Id(), "disassociate",
+			utils.ExpandToStringListBySet(oldPrincipalSet.Difference(newPrincipalSet)),
+			utils.ExpandToStringListBySet(oldResourceSet.Difference(newResourceSet)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateResourceShareAssociations(client, d.Id(), "associate",
+			utils.ExpandToStringListBySet(newPrincipalSet.Difference(oldPrincipalSet)),
+			utils.ExpandToStringListBySet(newResourceSet.Difference(oldResourceSet)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceShareRead(ctx, d, meta)
+}
+
+func resourceShareDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v1/resource-shares/{resource_share_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{resource_share_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting resource share")
+	}
+	return nil
+}
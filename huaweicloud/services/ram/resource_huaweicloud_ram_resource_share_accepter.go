@@ -0,0 +1,154 @@
+package ram
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceShareAccepter is used to accept (or reject) the resource share invitation in the principal account.
+func ResourceShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceShareAccepterCreate,
+		ReadContext:   resourceShareAccepterRead,
+		DeleteContext: resourceShareAccepterDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_share_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the resource share shared with the current account.`,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "accept",
+				ValidateFunc: validation.StringInSlice([]string{"accept", "reject"}, false),
+				Description:  `The action type of the resource share invitation.`,
+			},
+			"resource_share_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the resource share.`,
+			},
+			"sender_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the account that sends the invitation.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current status of the resource share invitation.`,
+			},
+		},
+	}
+}
+
+func queryResourceShareInvitation(client *golangsdk.ServiceClient, bodyParams map[string]interface{}) (interface{}, error) {
+	searchPath := client.Endpoint + "v1/resource-share-invitations/search"
+	searchOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         bodyParams,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("POST", searchPath, &searchOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	invitation := utils.PathSearch("resource_share_invitations[0]", respBody, nil)
+	if invitation == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return invitation, nil
+}
+
+func resourceShareAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	shareId := d.Get("resource_share_id").(string)
+	invitation, err := queryResourceShareInvitation(client, map[string]interface{}{
+		"resource_share_ids": []string{shareId},
+		"status":             "pending",
+	})
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return diag.Errorf("unable to find the pending invitation of the resource share (%s)", shareId)
+		}
+		return diag.Errorf("error querying the invitation of the resource share (%s): %s", shareId, err)
+	}
+	invitationId := utils.PathSearch("resource_share_invitation_id", invitation, "").(string)
+
+	action := d.Get("action").(string)
+	actionPath := client.Endpoint + "v1/resource-share-invitations/{invitation_id}/{action}"
+	actionPath = strings.ReplaceAll(actionPath, "{invitation_id}", invitationId)
+	actionPath = strings.ReplaceAll(actionPath, "{action}", action)
+	actionOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	_, err = client.Request("POST", actionPath, &actionOpt)
+	if err != nil {
+		return diag.Errorf("error trying to %s the resource share invitation (%s): %s", action, invitationId, err)
+	}
+	d.SetId(invitationId)
+
+	return resourceShareAccepterRead(ctx, d, meta)
+}
+
+func resourceShareAccepterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ram", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RAM client: %s", err)
+	}
+
+	invitation, err := queryResourceShareInvitation(client, map[string]interface{}{
+		"resource_share_invitation_ids": []string{d.Id()},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "RAM resource share invitation")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("resource_share_id", utils.PathSearch("resource_share_id", invitation, nil)),
+		d.Set("resource_share_name", utils.PathSearch("resource_share_name", invitation, nil)),
+		d.Set("sender_account_id", utils.PathSearch("sending_account_id", invitation, nil)),
+		d.Set("status", utils.PathSearch("status", invitation, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving resource share accepter (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceShareAccepterDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the resource share accepter is not supported. The accepter is only removed from the " +
+		"state, the shared resources remain available until the owner removes the current account from the share."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
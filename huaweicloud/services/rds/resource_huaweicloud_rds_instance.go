@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/bss/v2/orders"
@@ -33,6 +36,7 @@ func ResourceRdsInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceRdsInstanceCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(30 * time.Minute),
@@ -86,10 +90,15 @@ func ResourceRdsInstance() *schema.Resource {
 							ForceNew:         true,
 							DiffSuppressFunc: utils.SuppressCaseDiffs,
 						},
+						// Only the major version of PostgreSQL can be upgraded in place, see CustomizeDiff.
 						"version": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
+						},
+						"minor_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
 						},
 						"port": {
 							Type:     schema.TypeInt,
@@ -183,9 +192,38 @@ func ResourceRdsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d-([01]\d|2[0-3]):[0-5]\d$`),
+					"the format must be 'HH:MM-HH:MM'"),
+			},
+
+			"switch_in_maintenance_window": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"change_private_ip_on_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"parameters": common.ParametersSchema(),
+
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"pending_restart_parameters": common.PendingRestartParametersSchema(),
+
 			"param_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -205,6 +243,14 @@ func ResourceRdsInstance() *schema.Resource {
 
 			"tags": common.TagsSchema(),
 
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     rdsRestoreSourceSchema("restore"),
+			},
+
 			"time_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -277,6 +323,81 @@ func ResourceRdsInstance() *schema.Resource {
 	}
 }
 
+// rdsRestoreSourceSchema returns the schema of the backup or the point in time to be restored, it is shared by the
+// restore block of the RDS instance and the RDS instance restore resource. The parent is the key of the block, which is
+// used to check that exactly one of backup_id and restore_time is specified.
+func rdsRestoreSourceSchema(parent string) *schema.Resource {
+	exactlyOneOf := []string{parent + ".0.backup_id", parent + ".0.restore_time"}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: exactlyOneOf,
+			},
+			"restore_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: exactlyOneOf,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"database_name": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// buildRdsRestoreSourceBodyParams builds the restore point from the backup ID or the point in time of the source
+// instance, the point in time is converted from RFC3339 format to the timestamp in milliseconds.
+func buildRdsRestoreSourceBodyParams(raw map[string]interface{}) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"instance_id": raw["instance_id"],
+	}
+	// The databases can be renamed when restoring the Microsoft SQL Server instance.
+	if names := raw["database_name"].(map[string]interface{}); len(names) > 0 {
+		params["database_name"] = names
+	}
+	if backupId := raw["backup_id"].(string); backupId != "" {
+		params["type"] = "backup"
+		params["backup_id"] = backupId
+	} else {
+		restoreTime, err := time.Parse(time.RFC3339, raw["restore_time"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid restore_time: %s", err)
+		}
+		params["type"] = "timestamp"
+		params["restore_time"] = restoreTime.UnixNano() / int64(time.Millisecond)
+	}
+	return params, nil
+}
+
+// rdsInstanceRestoreCreateOpts is the options of restoring the backup or the point in time to a new instance, the
+// restore API is the creation API with the restore point.
+type rdsInstanceRestoreCreateOpts struct {
+	instances.CreateOpts
+	restorePoint map[string]interface{}
+}
+
+func (opts rdsInstanceRestoreCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	b["restore_point"] = utils.RemoveNil(opts.restorePoint)
+	return b, nil
+}
+
 func buildRdsInstanceDBPort(d *schema.ResourceData) string {
 	if v, ok := d.GetOk("db.0.port"); ok {
 		return strconv.Itoa(v.(int))
@@ -290,6 +411,29 @@ func isMySQLDatabase(d *schema.ResourceData) bool {
 	return strings.ToLower(dbType) == "mysql"
 }
 
+// resourceRdsInstanceCustomizeDiff rebuilds the instance when the major version is changed, except PostgreSQL which
+// supports the major version upgrade in place.
+func resourceRdsInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("db.0.version") && !strings.EqualFold(d.Get("db.0.type").(string), "PostgreSQL") {
+		return d.ForceNew("db.0.version")
+	}
+
+	// The major version upgrade and the replication mode change can not be delayed to the maintenance window, so they
+	// are rejected instead of being performed immediately.
+	if d.Get("switch_in_maintenance_window").(bool) {
+		for _, key := range []string{"db.0.version", "ha_replication_mode"} {
+			if d.HasChange(key) {
+				return fmt.Errorf("%s can not be changed in the maintenance window, please set "+
+					"switch_in_maintenance_window to false to change it immediately", key)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
@@ -343,7 +487,19 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("db.0.password").(string)
 
-	res, err := instances.Create(client, createOpts).Extract()
+	var createBuilder instances.CreateRdsBuilder = createOpts
+	if rawRestores := d.Get("restore").([]interface{}); len(rawRestores) > 0 && rawRestores[0] != nil {
+		restorePoint, err := buildRdsRestoreSourceBodyParams(rawRestores[0].(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createBuilder = rdsInstanceRestoreCreateOpts{
+			CreateOpts:   createOpts,
+			restorePoint: restorePoint,
+		}
+	}
+
+	res, err := instances.Create(client, createBuilder).Extract()
 	if err != nil {
 		return diag.Errorf("error creating RDS instance: %s", err)
 	}
@@ -401,6 +557,10 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if err = updateRdsInstanceParameters(ctx, d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRdsInstanceRead(ctx, d, meta)
 }
 
@@ -427,6 +587,7 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("status", instance.Status)
 	d.Set("created", instance.Created)
 	d.Set("ha_replication_mode", instance.Ha.ReplicationMode)
+	d.Set("maintenance_window", instance.MaintenanceWindow)
 	d.Set("vpc_id", instance.VpcId)
 	d.Set("subnet_id", instance.SubnetId)
 	d.Set("security_group_id", instance.SecurityGroupId)
@@ -462,12 +623,26 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error saving volume to RDS instance (%s): %s", instanceID, err)
 	}
 
+	completeVersion, err := getRdsInstanceCompleteVersion(client, instanceID)
+	if err != nil {
+		log.Printf("[WARN] error getting the complete version of RDS instance (%s): %s", instanceID, err)
+	}
+	// The minor version upgrade delayed to the maintenance window is not performed yet, the planned version is kept to
+	// avoid upgrading the instance again.
+	plannedVersion := d.Get("db.0.minor_version").(string)
+	if d.Get("switch_in_maintenance_window").(bool) && completeVersion != "" &&
+		compareRdsInstanceVersion(plannedVersion, completeVersion) > 0 {
+		log.Printf("[DEBUG] the minor version upgrade of RDS instance (%s) from %s to %s is pending", instanceID,
+			completeVersion, plannedVersion)
+		completeVersion = plannedVersion
+	}
 	dbList := make([]map[string]interface{}, 1)
 	database := map[string]interface{}{
-		"type":      instance.DataStore.Type,
-		"version":   instance.DataStore.Version,
-		"port":      instance.Port,
-		"user_name": instance.DbUserName,
+		"type":          instance.DataStore.Type,
+		"version":       instance.DataStore.Version,
+		"minor_version": completeVersion,
+		"port":          instance.Port,
+		"user_name":     instance.DbUserName,
 	}
 	if len(d.Get("db").([]interface{})) > 0 {
 		database["password"] = d.Get("db.0.password")
@@ -477,6 +652,10 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error saving data base to RDS instance (%s): %s", instanceID, err)
 	}
 
+	if diagErr := setRdsInstanceParameters(d, client, instanceID); diagErr != nil {
+		return diagErr
+	}
+
 	backup := make([]map[string]interface{}, 1)
 	backup[0] = map[string]interface{}{
 		"start_time": instance.BackupStrategy.StartTime,
@@ -546,6 +725,27 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	// The maintenance window is updated first, the delayed minor version upgrade is performed in the new window.
+	if err := updateRdsInstanceMaintenanceWindow(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceHaReplicationMode(ctx, d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceMajorVersion(ctx, d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceMinorVersion(ctx, d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceParameters(ctx, d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := updateRdsInstanceFlavor(d, config, client, instanceID, true); err != nil {
 		return diag.FromErr(err)
 	}
@@ -925,6 +1125,367 @@ func configRdsInstanceSSL(d *schema.ResourceData, client *golangsdk.ServiceClien
 	return nil
 }
 
+func updateRdsInstanceMaintenanceWindow(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("maintenance_window") {
+		return nil
+	}
+
+	window := strings.Split(d.Get("maintenance_window").(string), "-")
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"start_time": window[0],
+			"end_time":   window[1],
+		},
+	}
+	_, err := client.Request("PUT", client.ServiceURL("instances", instanceID, "ops-window"), &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating maintenance window of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+func updateRdsInstanceHaReplicationMode(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	if !d.HasChange("ha_replication_mode") {
+		return nil
+	}
+	if !strings.HasSuffix(d.Get("flavor").(string), ".ha") {
+		return fmt.Errorf("the replication mode can only be changed for the primary/standby RDS instance")
+	}
+
+	mode := d.Get("ha_replication_mode").(string)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"mode": mode,
+		},
+	}
+	_, err := client.Request("PUT", client.ServiceURL("instances", instanceID, "failover", "mode"), &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating replication mode of RDS instance (%s): %s", instanceID, err)
+	}
+
+	// The change is performed by a workflow instead of a job, so the instance is polled until the mode is changed.
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := GetRdsInstanceByID(client, instanceID)
+			if err != nil {
+				return nil, "FOUND ERROR", err
+			}
+			if instance.Status == "ACTIVE" && strings.EqualFold(instance.Ha.ReplicationMode, mode) {
+				return instance, "COMPLETED", nil
+			}
+			return instance, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) replication mode to be updated: %s", instanceID, err)
+	}
+	return nil
+}
+
+// waitForRdsInstanceJobCompleted waits for the job, unlike checkRDSInstanceJobFinish, an error is returned if the job
+// is failed.
+func waitForRdsInstanceJobCompleted(ctx context.Context, client *golangsdk.ServiceClient, jobID string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Running"},
+		Target:       []string{"Completed"},
+		Refresh:      rdsInstanceJobRefreshFunc(client, jobID),
+		Timeout:      timeout,
+		Delay:        20 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func sendRdsInstanceJobRequest(client *golangsdk.ServiceClient, url string, body map[string]interface{}) (string, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody:         body,
+	}
+	resp, err := client.Request("POST", url, &opt)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("job_id", respBody, "").(string), nil
+}
+
+// updateRdsInstanceMajorVersion upgrades the major version of the PostgreSQL instance, the pre-check is performed before
+// the upgrade, and the upgrade is not performed if the pre-check is failed.
+func updateRdsInstanceMajorVersion(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	if !d.HasChange("db.0.version") {
+		return nil
+	}
+
+	targetVersion := d.Get("db.0.version").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	checkBody := map[string]interface{}{
+		"target_version": targetVersion,
+		"action":         "upgradeCheck",
+	}
+	checkURL := client.ServiceURL("instances", instanceID, "major-version", "upgrade-check")
+	jobID, err := sendRdsInstanceJobRequest(client, checkURL, checkBody)
+	if err != nil {
+		return fmt.Errorf("error checking major version upgrade of RDS instance (%s): %s", instanceID, err)
+	}
+	if jobID != "" {
+		if err = waitForRdsInstanceJobCompleted(ctx, client, jobID, timeout); err != nil {
+			return fmt.Errorf("the pre-check of RDS instance (%s) major version upgrade to %s is failed, please check "+
+				"the detail in the console: %s", instanceID, targetVersion, err)
+		}
+	}
+
+	isChangePrivateIp := d.Get("change_private_ip_on_upgrade").(bool)
+	upgradeBody := map[string]interface{}{
+		"target_version":       targetVersion,
+		"is_change_private_ip": isChangePrivateIp,
+	}
+	if isChangePrivateIp {
+		upgradeBody["statistics_collection_mode"] = "before_change_private_ip"
+	}
+	upgradeURL := client.ServiceURL("instances", instanceID, "major-version", "upgrade")
+	jobID, err = sendRdsInstanceJobRequest(client, upgradeURL, upgradeBody)
+	if err != nil {
+		return fmt.Errorf("error upgrading major version of RDS instance (%s): %s", instanceID, err)
+	}
+	if jobID == "" {
+		return fmt.Errorf("error upgrading major version of RDS instance (%s): job ID is not found in API response",
+			instanceID)
+	}
+	if err = waitForRdsInstanceJobCompleted(ctx, client, jobID, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) major version upgrade job (%s) to be completed: %s",
+			instanceID, jobID, err)
+	}
+	return nil
+}
+
+// updateRdsInstanceMinorVersion upgrades the instance to the latest minor version, the API does not support to specify
+// the target version, so an error is returned if the configured version is not the latest one.
+func updateRdsInstanceMinorVersion(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	if !d.HasChange("db.0.minor_version") {
+		return nil
+	}
+	targetVersion := d.Get("db.0.minor_version").(string)
+	if targetVersion == "" {
+		return nil
+	}
+	currentVersion, err := getRdsInstanceCompleteVersion(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting the complete version of RDS instance (%s): %s", instanceID, err)
+	}
+	// The minor version may already be upgraded by the major version upgrade.
+	if currentVersion == targetVersion {
+		return nil
+	}
+	if compareRdsInstanceVersion(targetVersion, currentVersion) < 0 {
+		return fmt.Errorf("the minor version of RDS instance (%s) can not be downgraded from %s to %s", instanceID,
+			currentVersion, targetVersion)
+	}
+
+	isDelayed := d.Get("switch_in_maintenance_window").(bool)
+	upgradeURL := client.ServiceURL("instances", instanceID, "db-upgrade")
+	jobID, err := sendRdsInstanceJobRequest(client, upgradeURL, map[string]interface{}{"is_delayed": isDelayed})
+	if err != nil {
+		return fmt.Errorf("error upgrading minor version of RDS instance (%s): %s", instanceID, err)
+	}
+	if isDelayed {
+		log.Printf("[DEBUG] the minor version upgrade of RDS instance (%s) will be performed in the maintenance window",
+			instanceID)
+		return nil
+	}
+	if jobID == "" {
+		return fmt.Errorf("error upgrading minor version of RDS instance (%s): job ID is not found in API response",
+			instanceID)
+	}
+	if err = waitForRdsInstanceJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) minor version upgrade job (%s) to be completed: %s",
+			instanceID, jobID, err)
+	}
+
+	if currentVersion, err = getRdsInstanceCompleteVersion(client, instanceID); err != nil {
+		return fmt.Errorf("error getting the complete version of RDS instance (%s): %s", instanceID, err)
+	}
+	if currentVersion != targetVersion {
+		return fmt.Errorf("RDS instance (%s) is upgraded to the latest minor version %s, which is not the expected "+
+			"version %s", instanceID, currentVersion, targetVersion)
+	}
+	return nil
+}
+
+// compareRdsInstanceVersion compares the complete versions segment by segment, e.g. 8.0.28.231003 is newer than 8.0.28,
+// the result is 1 if v1 is newer than v2, -1 if v1 is older than v2, otherwise 0.
+func compareRdsInstanceVersion(v1, v2 string) int {
+	segments1 := strings.Split(v1, ".")
+	segments2 := strings.Split(v2, ".")
+	for i := 0; i < len(segments1) || i < len(segments2); i++ {
+		var n1, n2 int
+		if i < len(segments1) {
+			n1, _ = strconv.Atoi(segments1[i])
+		}
+		if i < len(segments2) {
+			n2, _ = strconv.Atoi(segments2[i])
+		}
+		if n1 != n2 {
+			if n1 > n2 {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
+
+// getRdsInstanceCompleteVersion returns the complete version (including the minor version) of the instance, which is
+// not supported by the SDK.
+func getRdsInstanceCompleteVersion(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("instances")+"?id="+instanceID, &getOpt)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("instances[0].datastore.complete_version", respBody, "").(string), nil
+}
+
+// updateRdsInstanceParameters applies the changed parameters to the instance, and restarts the instance if any
+// parameter takes effect after the restart and the restart_on_parameter_change is enabled.
+func updateRdsInstanceParameters(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	restartRequired := false
+	if values := common.ExpandChangedParameters(d); len(values) > 0 {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 202},
+			JSONBody: map[string]interface{}{
+				"values": values,
+			},
+		}
+		log.Printf("[DEBUG] Update parameters of RDS instance (%s): %#v", instanceID, values)
+		resp, err := client.Request("PUT", client.ServiceURL("instances", instanceID, "configurations"), &updateOpt)
+		if err != nil {
+			return fmt.Errorf("error updating parameters of RDS instance (%s): %s", instanceID, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return err
+		}
+		if jobID := utils.PathSearch("job_id", respBody, "").(string); jobID != "" {
+			if err = waitForRdsInstanceJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for RDS instance (%s) parameters to be updated: %s", instanceID, err)
+			}
+		}
+		restartRequired = utils.PathSearch("restart_required", respBody, false).(bool)
+	}
+
+	// The parameters left pending by the previous changes are also applied when the restart is enabled.
+	if !restartRequired && d.HasChange("restart_on_parameter_change") {
+		restartRequired = len(d.Get("pending_restart_parameters").([]interface{})) > 0
+	}
+	if !restartRequired || !d.Get("restart_on_parameter_change").(bool) {
+		return nil
+	}
+	return restartRdsInstance(ctx, d, client, instanceID)
+}
+
+// restartRdsInstance restarts the instance immediately and waits for it to become ACTIVE. If the
+// switch_in_maintenance_window is enabled, the restart is performed in the maintenance window and is not waited.
+func restartRdsInstance(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	isDelayed := d.Get("switch_in_maintenance_window").(bool)
+	restartOpts := map[string]interface{}{}
+	if isDelayed {
+		restartOpts["delay"] = true
+	}
+	restartURL := client.ServiceURL("instances", instanceID, "action")
+	jobID, err := sendRdsInstanceJobRequest(client, restartURL, map[string]interface{}{"restart": restartOpts})
+	if err != nil {
+		return fmt.Errorf("error restarting RDS instance (%s): %s", instanceID, err)
+	}
+	if isDelayed {
+		log.Printf("[DEBUG] the restart of RDS instance (%s) will be performed in the maintenance window", instanceID)
+		return nil
+	}
+
+	if jobID != "" {
+		if err = waitForRdsInstanceJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for RDS instance (%s) restart job (%s) to be completed: %s",
+				instanceID, jobID, err)
+		}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"REBOOTING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      rdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) to become ACTIVE after restart: %s", instanceID, err)
+	}
+	return nil
+}
+
+// setRdsInstanceParameters saves the configured parameters and the parameters which are modified but not applied
+// until the instance is restarted. The failure of the queries is not fatal, the values in the state are kept.
+func setRdsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) diag.Diagnostics {
+	mErr := &multierror.Error{}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("instances", instanceID, "configurations"), &getOpt)
+	if err != nil {
+		log.Printf("[WARN] error getting parameters of RDS instance (%s): %s", instanceID, err)
+	} else if respBody, err := utils.FlattenResponse(resp); err != nil {
+		log.Printf("[WARN] error parsing parameters of RDS instance (%s): %s", instanceID, err)
+	} else {
+		values := make(map[string]string)
+		params := utils.PathSearch("configuration_parameters", respBody, make([]interface{}, 0)).([]interface{})
+		for _, param := range params {
+			values[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, "").(string)
+		}
+		mErr = multierror.Append(mErr, d.Set("parameters", common.FlattenConfiguredParameters(d, values)))
+	}
+
+	// The modification histories record whether the parameter is applied.
+	historiesURL := client.ServiceURL("instances", instanceID, "configuration-histories")
+	if pending, err := common.GetPendingRestartParameters(client, historiesURL); err != nil {
+		log.Printf("[WARN] error getting parameter histories of RDS instance (%s): %s", instanceID, err)
+	} else {
+		mErr = multierror.Append(mErr, d.Set("pending_restart_parameters", pending))
+	}
+
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
 func checkRDSInstanceJobFinish(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{"Running"},
@@ -0,0 +1,163 @@
+package rds
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRdsInstanceRestore is the impl for huaweicloud_rds_instance_restore resource, which restores a backup or a
+// point in time of the source instance to an existing instance. The data of the target instance is overwritten.
+func ResourceRdsInstanceRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsInstanceRestoreCreate,
+		ReadContext:   resourceRdsInstanceRestoreRead,
+		DeleteContext: resourceRdsInstanceRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     rdsRestoreSourceSchema("source"),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRdsInstanceRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	source, err := buildRdsRestoreSourceBodyParams(d.Get("source").([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	restorePath := client.Endpoint + "v3.1/{project_id}/instances/recovery"
+	restorePath = strings.ReplaceAll(restorePath, "{project_id}", client.ProjectID)
+	restoreOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		JSONBody: map[string]interface{}{
+			"source": utils.RemoveNil(source),
+			"target": map[string]interface{}{
+				"instance_id": instanceId,
+			},
+		},
+	}
+	resp, err := client.Request("POST", restorePath, &restoreOpt)
+	if err != nil {
+		return diag.Errorf("error restoring RDS instance (%s): %s", instanceId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobId := utils.PathSearch("job_id", respBody, "").(string)
+	if jobId == "" {
+		return diag.Errorf("error restoring RDS instance (%s): job ID is not found in API response", instanceId)
+	}
+	d.SetId(jobId)
+
+	v3Client, err := cfg.RdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS v3 client: %s", err)
+	}
+	// Unlike the instance creation, the restoration fails if the job is failed.
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Running"},
+		Target:       []string{"Completed"},
+		Refresh:      rdsInstanceJobRefreshFunc(v3Client, jobId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        20 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for RDS instance (%s) restoration job (%s) to be completed: %s",
+			instanceId, jobId, err)
+	}
+
+	return resourceRdsInstanceRestoreRead(ctx, d, meta)
+}
+
+func resourceRdsInstanceRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.RdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS v3 client: %s", err)
+	}
+
+	// The restoration is removed from the state if the target instance is deleted.
+	instanceId := d.Get("instance_id").(string)
+	instance, err := GetRdsInstanceByID(client, instanceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if instance.Id == "" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "RDS instance restore")
+	}
+
+	job, status, err := rdsInstanceJobRefreshFunc(client, d.Id())()
+	if err != nil || job == nil {
+		// The job records are only retained for a limited period, so the status is kept.
+		status = d.Get("status").(string)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("status", status),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving RDS instance restore fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRdsInstanceRestoreDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the RDS instance restore is not supported. The restore is only removed from the state, " +
+		"the restored data remains in the target instance."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,292 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/scm/v3/certificates"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// targetServiceElb is the service name of the dedicated ELB, which is used by the deployment API.
+const targetServiceElb = "ELB"
+
+// The certificate statuses which will never turn to ISSUED.
+var certificateFailedStatuses = []string{"CANCELLED", "REVOKED", "EXPIRED"}
+
+// ResourceScmCertificateDeployment is the impl of huaweicloud_scm_certificate_deployment, which waits for the SCM
+// certificate to be issued and deploys it to the ELB certificates, the WAF certificates or the CDN domains.
+// The certificate is re-deployed if a renewed certificate (the expiration time is changed) is detected during refresh.
+func ResourceScmCertificateDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScmCertificateDeploymentCreate,
+		ReadContext:   resourceScmCertificateDeploymentRead,
+		UpdateContext: resourceScmCertificateDeploymentUpdate,
+		DeleteContext: resourceScmCertificateDeploymentDelete,
+
+		CustomizeDiff: resourceScmCertificateDeploymentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					targetServiceElb, targetServiceWaf, targetServiceCdn,
+				}, false),
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enterprise_project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"certificate_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployed_not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// waitForCertificateIssued waits for the certificate to be issued, which means the domain validation is completed.
+func waitForCertificateIssued(ctx context.Context, client *golangsdk.ServiceClient, certId string,
+	timeout time.Duration) (*certificates.CertificateEscrowInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			cert, err := certificates.Get(client, certId).Extract()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if cert.Status == certificateStatusIssued {
+				return cert, "COMPLETED", nil
+			}
+			if utils.StrSliceContains(certificateFailedStatuses, cert.Status) {
+				return cert, "ERROR", fmt.Errorf("unexpected certificate status: %s", cert.Status)
+			}
+			log.Printf("[DEBUG] the SCM certificate (%s) is not issued, the current status is %s", certId, cert.Status)
+			return cert, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+	cert, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for SCM certificate (%s) to be issued: %s", certId, err)
+	}
+	return cert.(*certificates.CertificateEscrowInfo), nil
+}
+
+func buildDeploymentResourcesBodyParams(resources []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, v := range resources {
+		raw, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		result = append(result, utils.RemoveNil(map[string]interface{}{
+			"id":                    utils.ValueIngoreEmpty(raw["id"]),
+			"domain_name":           utils.ValueIngoreEmpty(raw["domain_name"]),
+			"enterprise_project_id": utils.ValueIngoreEmpty(raw["enterprise_project_id"]),
+		}))
+	}
+	return result
+}
+
+// deployCertificate waits for the certificate to be issued and deploys it to the resources, the expiration time of the
+// deployed certificate is recorded in deployed_not_after.
+func deployCertificate(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient,
+	timeout time.Duration) error {
+	certId := d.Get("certificate_id").(string)
+	cert, err := waitForCertificateIssued(ctx, client, certId, timeout)
+	if err != nil {
+		return err
+	}
+
+	deployPath := client.Endpoint + fmt.Sprintf("scm/certificates/%s/deploy", certId)
+	deployOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"project_name": utils.ValueIngoreEmpty(d.Get("project_name")),
+			"service_name": d.Get("service"),
+			"resources":    buildDeploymentResourcesBodyParams(d.Get("resources").([]interface{})),
+		}),
+	}
+	if _, err = client.Request("POST", deployPath, &deployOpt); err != nil {
+		return fmt.Errorf("error deploying SCM certificate (%s) to %s: %s", certId, d.Get("service"), err)
+	}
+	return d.Set("deployed_not_after", cert.NotAfter)
+}
+
+func resourceScmCertificateDeploymentCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ScmV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SCM client: %s", err)
+	}
+
+	// The CDN domains are global resources, so the project name is only required by ELB and WAF.
+	if _, ok := d.GetOk("project_name"); !ok && d.Get("service").(string) != targetServiceCdn {
+		if err = d.Set("project_name", region); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = deployCertificate(ctx, d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("certificate_id"), d.Get("service"), d.Get("project_name")))
+
+	return resourceScmCertificateDeploymentRead(ctx, d, meta)
+}
+
+func resourceScmCertificateDeploymentRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ScmV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SCM client: %s", err)
+	}
+
+	cert, err := certificates.Get(client, d.Get("certificate_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SCM certificate")
+	}
+
+	// The deployed_not_after is not refreshed, the difference between it and certificate_not_after means that the
+	// certificate has been renewed and needs to be re-deployed.
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("certificate_status", cert.Status),
+		d.Set("certificate_not_after", cert.NotAfter),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SCM certificate deployment fields: %s", err)
+	}
+	return nil
+}
+
+func resourceScmCertificateDeploymentUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.ScmV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SCM client: %s", err)
+	}
+
+	if d.HasChanges("resources", "deployed_not_after") {
+		if err = deployCertificate(ctx, d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceScmCertificateDeploymentRead(ctx, d, meta)
+}
+
+func resourceScmCertificateDeploymentDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The deployed certificate can not be withdrawn from the target resources, so only the state is removed.
+	log.Printf("[WARN] the SCM certificate (%s) is still used by the resources of %s after the deployment is deleted",
+		d.Get("certificate_id"), d.Get("service"))
+	return nil
+}
+
+// resourceScmCertificateDeploymentCustomizeDiff plans a re-deployment when the certificate has been renewed, that is,
+// the expiration time refreshed from SCM is different from the one of the deployed certificate.
+func resourceScmCertificateDeploymentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if err := validateDeploymentResources(d); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	current := d.Get("certificate_not_after").(string)
+	if current != "" && current != d.Get("deployed_not_after").(string) {
+		log.Printf("[DEBUG] the SCM certificate (%s) has been renewed, the new expiration time is %s",
+			d.Get("certificate_id"), current)
+		return d.SetNew("deployed_not_after", current)
+	}
+	return nil
+}
+
+// validateDeploymentResources checks that the CDN resources are specified by the domain names and the ELB and WAF
+// resources are specified by the certificate IDs.
+func validateDeploymentResources(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("service") || !d.NewValueKnown("resources") {
+		return nil
+	}
+
+	service := d.Get("service").(string)
+	key, field := "id", "the certificate ID"
+	if service == targetServiceCdn {
+		key, field = "domain_name", "the domain name"
+	}
+	for i, v := range d.Get("resources").([]interface{}) {
+		raw, ok := v.(map[string]interface{})
+		if !ok || strings.TrimSpace(fmt.Sprint(raw[key])) == "" {
+			return fmt.Errorf("invalid resources.%d: %s is required when deploying to %s", i, field, service)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/scm/v3/certificates"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	certificateStatusIssued = "ISSUED"
+
+	domainValidationMethodDNS = "DNS"
+)
+
+// ResourceScmManagedCertificate is the impl of huaweicloud_scm_managed_certificate, which orders a certificate from the
+// CA and applies for it with the DNS validation. The validation records are exported so that they can be created by
+// huaweicloud_dns_recordset, and the issued certificate is deployed by huaweicloud_scm_certificate_deployment.
+func ResourceScmManagedCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScmManagedCertificateCreate,
+		ReadContext:   resourceScmManagedCertificateRead,
+		DeleteContext: resourceScmManagedCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cert_brand": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cert_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"SINGLE_DOMAIN", "MULTI_DOMAIN", "WILDCARD",
+				}, false),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sans": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+			"contact_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"contact_phone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"contact_email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "RSA_2048",
+			},
+			"order_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validation_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_validation_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"push_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issue_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildBuyCertificateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	domainNumbers := 1 + len(d.Get("sans").([]interface{}))
+	return map[string]interface{}{
+		"cert_brand":     d.Get("cert_brand"),
+		"cert_type":      d.Get("cert_type"),
+		"domain_type":    d.Get("domain_type"),
+		"domain_numbers": domainNumbers,
+		"effective_time": d.Get("period"),
+		"order_number":   1,
+		"is_auto_pay":    true,
+	}
+}
+
+func buildApplyCertificateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	// The additional domain names are separated by semicolons (;).
+	sans := strings.Join(utils.ExpandToStringList(d.Get("sans").([]interface{})), ";")
+	return utils.RemoveNil(map[string]interface{}{
+		"domain":                   d.Get("domain"),
+		"sans":                     utils.ValueIngoreEmpty(sans),
+		"csr_type":                 "system",
+		"key_algorithm":            d.Get("key_algorithm"),
+		"domain_method":            domainValidationMethodDNS,
+		"contact_name":             d.Get("contact_name"),
+		"contact_phone":            d.Get("contact_phone"),
+		"contact_email":            d.Get("contact_email"),
+		"company_name":             utils.ValueIngoreEmpty(d.Get("company_name")),
+		"agree_privacy_protection": true,
+	})
+}
+
+func resourceScmManagedCertificateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ScmV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SCM client: %s", err)
+	}
+
+	buyPath := client.Endpoint + "scm/certificates/buy"
+	buyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildBuyCertificateBodyParams(d),
+	}
+	buyResp, err := client.Request("POST", buyPath, &buyOpt)
+	if err != nil {
+		return diag.Errorf("error ordering SCM certificate: %s", err)
+	}
+	buyRespBody, err := utils.FlattenResponse(buyResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orderId := utils.PathSearch("order_id", buyRespBody, "").(string)
+	certId := utils.PathSearch("cert_id_list|[0]", buyRespBody, "").(string)
+	if orderId == "" || certId == "" {
+		return diag.Errorf("error ordering SCM certificate: the order ID or certificate ID is not found in the response")
+	}
+	d.SetId(certId)
+	if err = d.Set("order_id", orderId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	bssClient, err := cfg.BssV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BSS v2 client: %s", err)
+	}
+	if err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the order (%s) of SCM certificate to complete: %s", orderId, err)
+	}
+
+	applyPath := client.Endpoint + fmt.Sprintf("scm/certificates/%s/apply", certId)
+	applyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildApplyCertificateBodyParams(d),
+	}
+	if _, err = client.Request("POST", applyPath, &applyOpt); err != nil {
+		return diag.Errorf("error applying for SCM certificate (%s): %s", certId, err)
+	}
+
+	if err = waitForDNSValidationRecords(ctx, client, certId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceScmManagedCertificateRead(ctx, d, meta)
+}
+
+// waitForDNSValidationRecords waits for the CA to generate the DNS validation records after the application is
+// submitted, so that the records can be referenced by huaweicloud_dns_recordset in the same apply.
+func waitForDNSValidationRecords(ctx context.Context, client *golangsdk.ServiceClient, certId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			cert, err := certificates.Get(client, certId).Extract()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			// The certificate may be issued directly if the domain has been validated before.
+			if len(cert.Authentifications) > 0 || cert.Status == certificateStatusIssued {
+				return cert, "COMPLETED", nil
+			}
+			if utils.StrSliceContains(certificateFailedStatuses, cert.Status) {
+				return cert, "ERROR", fmt.Errorf("unexpected certificate status: %s", cert.Status)
+			}
+			log.Printf("[DEBUG] the DNS validation records of SCM certificate (%s) are not generated, the current "+
+				"status is %s", certId, cert.Status)
+			return cert, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the DNS validation records of SCM certificate (%s): %s", certId, err)
+	}
+	return nil
+}
+
+func flattenDNSValidationRecords(authentifications []certificates.Authentification) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(authentifications))
+	for _, v := range authentifications {
+		result = append(result, map[string]interface{}{
+			"domain":       v.Domain,
+			"record_name":  v.RecordName,
+			"record_type":  v.RecordType,
+			"record_value": v.RecordValue,
+		})
+	}
+	return result
+}
+
+func resourceScmManagedCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.ScmV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SCM client: %s", err)
+	}
+
+	cert, err := certificates.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SCM certificate")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("order_id", cert.OrderId),
+		d.Set("name", cert.Name),
+		d.Set("domain", cert.Domain),
+		d.Set("status", cert.Status),
+		d.Set("validation_method", cert.ValidationMethod),
+		d.Set("dns_validation_records", flattenDNSValidationRecords(cert.Authentifications)),
+		d.Set("push_support", cert.PushSupport),
+		d.Set("issue_time", cert.IssueTime),
+		d.Set("not_before", cert.NotBefore),
+		d.Set("not_after", cert.NotAfter),
+	)
+	// The brand, type and additional domains returned by the API may be different from the ordered values (e.g. the
+	// display names and the order of the domains), so they are only refreshed when importing.
+	if _, ok := d.GetOk("cert_brand"); !ok {
+		mErr = multierror.Append(mErr,
+			d.Set("cert_brand", cert.Brand),
+			d.Set("cert_type", cert.CertificateType),
+			d.Set("domain_type", cert.DomainType),
+		)
+		if cert.Sans != "" {
+			mErr = multierror.Append(mErr, d.Set("sans", strings.Split(cert.Sans, ";")))
+		}
+	}
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SCM certificate fields: %s", err)
+	}
+	return nil
+}
+
+func resourceScmManagedCertificateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	// The ordered certificate is a prepaid resource, it is released by unsubscribing the order.
+	if err := common.UnsubscribePrePaidResource(d, cfg, []string{d.Id()}); err != nil {
+		return diag.Errorf("error unsubscribing SCM certificate (%s): %s", d.Id(), err)
+	}
+	return nil
+}
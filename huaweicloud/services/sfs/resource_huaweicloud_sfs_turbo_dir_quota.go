@@ -0,0 +1,236 @@
+package sfs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceTurboDirQuota is the impl for huaweicloud_sfs_turbo_dir_quota resource, which limits the capacity and the
+// number of files of a directory of the SFS Turbo. The directory is created if it does not exist.
+func ResourceTurboDirQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTurboDirQuotaCreate,
+		ReadContext:   resourceTurboDirQuotaRead,
+		UpdateContext: resourceTurboDirQuotaUpdate,
+		DeleteContext: resourceTurboDirQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTurboDirQuotaImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{"inode"},
+			},
+			"inode": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"used_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_inode": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildTurboDirQuotaBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"path":     d.Get("path"),
+		"capacity": d.Get("capacity"),
+		"inode":    d.Get("inode"),
+	}
+}
+
+// createTurboDirIfNotExist creates the directory if the directory is not found in the file system.
+func createTurboDirIfNotExist(client *golangsdk.ServiceClient, shareId, path string) error {
+	dirPath := client.ServiceURL("sfs-turbo", "shares", shareId, "fs", "dir")
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	_, err := client.Request("GET", dirPath+"?path="+url.QueryEscape(path), &getOpt)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(golangsdk.ErrDefault404); !ok {
+		return err
+	}
+
+	log.Printf("[DEBUG] The directory (%s) of the SFS Turbo (%s) is not found, create it", path, shareId)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 204},
+		JSONBody: map[string]interface{}{
+			"path": path,
+		},
+	}
+	_, err = client.Request("POST", dirPath, &createOpt)
+	return err
+}
+
+func resourceTurboDirQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	shareId := d.Get("share_id").(string)
+	path := d.Get("path").(string)
+	if err = createTurboDirIfNotExist(client, shareId, path); err != nil {
+		return diag.Errorf("error creating directory (%s) of the SFS Turbo (%s): %s", path, shareId, err)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody:         buildTurboDirQuotaBodyParams(d),
+	}
+	createPath := client.ServiceURL("sfs-turbo", "shares", shareId, "fs", "dir-quota")
+	if _, err = client.Request("POST", createPath, &createOpt); err != nil {
+		return diag.Errorf("error creating quota of the directory (%s): %s", path, err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceTurboDirQuotaRead(ctx, d, meta)
+}
+
+func resourceTurboDirQuotaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.SfsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "dir-quota")
+	resp, err := client.Request("GET", getPath+"?path="+url.QueryEscape(d.Get("path").(string)), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving directory quota of the SFS Turbo")
+	}
+	quota, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("path", utils.PathSearch("path", quota, nil)),
+		d.Set("capacity", utils.PathSearch("capacity", quota, nil)),
+		d.Set("inode", utils.PathSearch("inode", quota, nil)),
+		d.Set("used_capacity", utils.PathSearch("used_capacity", quota, nil)),
+		d.Set("used_inode", utils.PathSearch("used_inode", quota, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving directory quota fields: %s", err)
+	}
+	return nil
+}
+
+func resourceTurboDirQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         buildTurboDirQuotaBodyParams(d),
+	}
+	updatePath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "dir-quota")
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating quota of the directory (%s): %s", d.Get("path"), err)
+	}
+	return resourceTurboDirQuotaRead(ctx, d, meta)
+}
+
+func resourceTurboDirQuotaDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	// Only the quota is removed, the directory and its files are retained.
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"path": d.Get("path"),
+		},
+	}
+	deletePath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "dir-quota")
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting directory quota of the SFS Turbo")
+	}
+	return nil
+}
+
+// resourceTurboDirQuotaImportState is the import function of the directory quota, the format of the import ID is
+// '<share_id>/<path>', e.g. '6b2d1c4e-xxxx/dir/sub_dir'.
+func resourceTurboDirQuotaImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format for import ID, want '<share_id>/<path>', but '%s'", d.Id())
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	mErr := multierror.Append(nil,
+		d.Set("share_id", parts[0]),
+		d.Set("path", "/"+strings.TrimPrefix(parts[1], "/")),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,368 @@
+package sfs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceTurboObsTarget is the impl for huaweicloud_sfs_turbo_obs_target resource, which binds an OBS bucket to a
+// directory of the SFS Turbo as the storage backend (data repository). The objects of the bucket are imported to the
+// directory, and the changes of the files can be exported to the bucket automatically.
+func ResourceTurboObsTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTurboObsTargetCreate,
+		ReadContext:   resourceTurboObsTargetRead,
+		UpdateContext: resourceTurboObsTargetUpdate,
+		DeleteContext: resourceTurboObsTargetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTurboSubResourceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_system_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"auto_export_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"events": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"NEW", "CHANGED", "DELETED"}, false),
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"suffix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"file_mode": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"dir_mode": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"uid": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"gid": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"delete_data_in_file_system": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildTurboObsTargetPolicy(policies []interface{}) map[string]interface{} {
+	if len(policies) < 1 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return map[string]interface{}{
+		"auto_export_policy": utils.RemoveNil(map[string]interface{}{
+			"events": utils.ValueIngoreEmpty(policy["events"]),
+			"prefix": utils.ValueIngoreEmpty(policy["prefix"]),
+			"suffix": utils.ValueIngoreEmpty(policy["suffix"]),
+		}),
+	}
+}
+
+func buildTurboObsTargetAttributes(attributes []interface{}) map[string]interface{} {
+	if len(attributes) < 1 || attributes[0] == nil {
+		return nil
+	}
+
+	attribute := attributes[0].(map[string]interface{})
+	return utils.RemoveNil(map[string]interface{}{
+		"file_mode": utils.ValueIngoreEmpty(attribute["file_mode"]),
+		"dir_mode":  utils.ValueIngoreEmpty(attribute["dir_mode"]),
+		"uid":       utils.ValueIngoreEmpty(attribute["uid"]),
+		"gid":       utils.ValueIngoreEmpty(attribute["gid"]),
+	})
+}
+
+func buildTurboObsTargetBodyParams(d *schema.ResourceData) map[string]interface{} {
+	obs := d.Get("obs").([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"file_system_path": d.Get("file_system_path"),
+		"obs": utils.RemoveNil(map[string]interface{}{
+			"bucket":     obs["bucket"],
+			"endpoint":   obs["endpoint"],
+			"policy":     buildTurboObsTargetPolicy(obs["auto_export_policy"].([]interface{})),
+			"attributes": buildTurboObsTargetAttributes(obs["attributes"].([]interface{})),
+		}),
+	}
+}
+
+func resourceTurboObsTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	shareId := d.Get("share_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 202},
+		JSONBody:         buildTurboObsTargetBodyParams(d),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("sfs-turbo", "shares", shareId, "targets"), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating OBS target of the SFS Turbo (%s): %s", shareId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetId := utils.PathSearch("target_id", respBody, "").(string)
+	if targetId == "" {
+		return diag.Errorf("error creating OBS target of the SFS Turbo (%s): ID is not found in API response", shareId)
+	}
+	d.SetId(targetId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			target, err := getTurboObsTarget(client, shareId, targetId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("lifecycle", target, "").(string)
+			if status == "FAILED" || status == "MISCONFIGURED" {
+				return target, status, fmt.Errorf("unexpected status: %s", status)
+			}
+			return target, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for OBS target (%s) to be available: %s", targetId, err)
+	}
+	return resourceTurboObsTargetRead(ctx, d, meta)
+}
+
+func getTurboObsTarget(client *golangsdk.ServiceClient, shareId, targetId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("sfs-turbo", "shares", shareId, "targets", targetId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func flattenTurboObsTarget(target interface{}) []map[string]interface{} {
+	obs := map[string]interface{}{
+		"bucket":   utils.PathSearch("obs.bucket", target, nil),
+		"endpoint": utils.PathSearch("obs.endpoint", target, nil),
+	}
+	if policy := utils.PathSearch("obs.policy.auto_export_policy", target, nil); policy != nil {
+		obs["auto_export_policy"] = []map[string]interface{}{
+			{
+				"events": utils.PathSearch("events", policy, nil),
+				"prefix": utils.PathSearch("prefix", policy, nil),
+				"suffix": utils.PathSearch("suffix", policy, nil),
+			},
+		}
+	}
+	if attributes := utils.PathSearch("obs.attributes", target, nil); attributes != nil {
+		obs["attributes"] = []map[string]interface{}{
+			{
+				"file_mode": utils.PathSearch("file_mode", attributes, nil),
+				"dir_mode":  utils.PathSearch("dir_mode", attributes, nil),
+				"uid":       utils.PathSearch("uid", attributes, nil),
+				"gid":       utils.PathSearch("gid", attributes, nil),
+			},
+		}
+	}
+	return []map[string]interface{}{obs}
+}
+
+func resourceTurboObsTargetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.SfsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	target, err := getTurboObsTarget(client, d.Get("share_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OBS target of the SFS Turbo")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("file_system_path", utils.PathSearch("file_system_path", target, nil)),
+		d.Set("obs", flattenTurboObsTarget(target)),
+		d.Set("status", utils.PathSearch("lifecycle", target, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving OBS target fields: %s", err)
+	}
+	return nil
+}
+
+// resourceTurboObsTargetUpdate is only used to update the delete_data_in_file_system, which is used in the deletion.
+func resourceTurboObsTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceTurboObsTargetRead(ctx, d, meta)
+}
+
+func resourceTurboObsTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	shareId := d.Get("share_id").(string)
+	deletePath := client.ServiceURL("sfs-turbo", "shares", shareId, "targets", d.Id())
+	deletePath += fmt.Sprintf("?delete_data_in_file_system=%v", d.Get("delete_data_in_file_system"))
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202, 204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting OBS target of the SFS Turbo")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			target, err := getTurboObsTarget(client, shareId, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return target, "DELETING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for OBS target (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// resourceTurboSubResourceImportState is the import function of the resources belonging to the SFS Turbo, the format
+// of the import ID is '<share_id>/<id>'.
+func resourceTurboSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format for import ID, want '<share_id>/<id>', but '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("share_id", parts[0])
+}
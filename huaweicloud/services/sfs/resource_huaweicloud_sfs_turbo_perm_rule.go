@@ -0,0 +1,175 @@
+package sfs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceTurboPermRule is the impl for huaweicloud_sfs_turbo_perm_rule resource, which manages the NFS permission
+// rule of the client IP addresses of the SFS Turbo.
+func ResourceTurboPermRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTurboPermRuleCreate,
+		ReadContext:   resourceTurboPermRuleRead,
+		UpdateContext: resourceTurboPermRuleUpdate,
+		DeleteContext: resourceTurboPermRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTurboSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rw_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"rw", "ro", "none"}, false),
+			},
+			"user_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"no_root_squash", "root_squash", "all_squash",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceTurboPermRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	shareId := d.Get("share_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201},
+		JSONBody: map[string]interface{}{
+			"rules": []map[string]interface{}{
+				{
+					"ip_cidr":   d.Get("ip_cidr"),
+					"rw_type":   d.Get("rw_type"),
+					"user_type": d.Get("user_type"),
+				},
+			},
+		},
+	}
+	createPath := client.ServiceURL("sfs-turbo", "shares", shareId, "fs", "perm-rules")
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating permission rule of the SFS Turbo (%s): %s", shareId, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId := utils.PathSearch("rules[0].id", respBody, "").(string)
+	if ruleId == "" {
+		return diag.Errorf("error creating permission rule of the SFS Turbo (%s): ID is not found in API response",
+			shareId)
+	}
+	d.SetId(ruleId)
+
+	return resourceTurboPermRuleRead(ctx, d, meta)
+}
+
+func resourceTurboPermRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.SfsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getPath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "perm-rules", d.Id())
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving permission rule of the SFS Turbo")
+	}
+	rule, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("ip_cidr", utils.PathSearch("ip_cidr", rule, nil)),
+		d.Set("rw_type", utils.PathSearch("rw_type", rule, nil)),
+		d.Set("user_type", utils.PathSearch("user_type", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving permission rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceTurboPermRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"rw_type":   d.Get("rw_type"),
+			"user_type": d.Get("user_type"),
+		},
+	}
+	updatePath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "perm-rules", d.Id())
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating permission rule (%s) of the SFS Turbo: %s", d.Id(), err)
+	}
+	return resourceTurboPermRuleRead(ctx, d, meta)
+}
+
+func resourceTurboPermRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.SfsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating SFS v1 client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+	}
+	deletePath := client.ServiceURL("sfs-turbo", "shares", d.Get("share_id").(string), "fs", "perm-rules", d.Id())
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting permission rule of the SFS Turbo")
+	}
+	return nil
+}
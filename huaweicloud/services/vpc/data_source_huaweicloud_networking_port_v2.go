@@ -3,6 +3,7 @@ package vpc
 import (
 	"context"
 	"log"
+	"net"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -176,7 +177,7 @@ func dataSourceNetworkingPortV2Read(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("fixed_ip"); ok {
 		for _, p := range allPorts {
 			for _, ipObject := range p.FixedIPs {
-				if v.(string) == ipObject.IPAddress {
+				if net.ParseIP(v.(string)).Equal(net.ParseIP(ipObject.IPAddress)) {
 					portsList = append(portsList, p)
 				}
 			}
@@ -60,6 +60,14 @@ func DataSourceVpcSubnets() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -192,7 +200,16 @@ func dataSourceVpcSubnetsRead(_ context.Context, d *schema.ResourceData, meta in
 	var subnets []map[string]interface{}
 	tagFilter := d.Get("tags").(map[string]interface{})
 	var ids []string
+	ipv6Enable, ipv6EnableSet := d.GetOk("ipv6_enable")
+	ipv6Cidr := d.Get("ipv6_cidr").(string)
 	for _, item := range subnetList {
+		if ipv6EnableSet && item.EnableIPv6 != ipv6Enable.(bool) {
+			continue
+		}
+		if ipv6Cidr != "" && !utils.IsSameCIDR(item.IPv6CIDR, ipv6Cidr) {
+			continue
+		}
+
 		subnet := map[string]interface{}{
 			"id":                item.ID,
 			"name":              item.Name,
@@ -51,10 +51,11 @@ func ResourceVPCRouteTableRoute() *schema.Resource {
 				ForceNew: true,
 			},
 			"destination": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: utils.ValidateCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     utils.ValidateCIDR,
+				DiffSuppressFunc: utils.SuppressEquivalentCIDRDiffs,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -166,7 +167,8 @@ func resourceVpcRTBRouteRead(_ context.Context, d *schema.ResourceData, meta int
 
 	var route *routetables.Route
 	for index := range routeTable.Routes {
-		if routeTable.Routes[index].DestinationCIDR == destination {
+		// The IPv6 destination may be returned in a different format.
+		if utils.IsSameCIDR(routeTable.Routes[index].DestinationCIDR, destination) {
 			route = &routeTable.Routes[index]
 			break
 		}
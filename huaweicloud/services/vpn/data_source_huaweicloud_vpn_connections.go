@@ -0,0 +1,292 @@
+package vpn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceConnections is used to query the VPN connections and their tunnel status.
+func DataSourceConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region where the VPN connections are located.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN gateway ID used to filter the connections.`,
+			},
+			"gateway_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN gateway IP ID used to filter the connections.`,
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The VPN connection ID used to filter the connections.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The name used to filter the connections.`,
+			},
+			"vpn_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"policy", "static", "bgp"}, false),
+				Description:  `The connection type used to filter the connections.`,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "DOWN", "ERROR"}, false),
+				Description:  `The tunnel status used to filter the connections.`,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the VPN connection.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the VPN connection.`,
+						},
+						"gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPN gateway ID.`,
+						},
+						"gateway_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPN gateway IP ID.`,
+						},
+						"customer_gateway_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The customer gateway ID.`,
+						},
+						"vpn_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The connection type.`,
+						},
+						"ha_role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The role of the connection in the active-standby gateway.`,
+						},
+						"tunnel_local_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The local tunnel interface address.`,
+						},
+						"tunnel_peer_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The peer tunnel interface address.`,
+						},
+						"enable_nqa": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether NQA check is enabled.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The tunnel status of the VPN connection.`,
+						},
+						"health_check_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The status of the health check configured for the VPN connection.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The creation time.`,
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The latest update time.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildConnectionsQueryParams(d *schema.ResourceData) string {
+	res := "?limit=200"
+	if v, ok := d.GetOk("gateway_id"); ok {
+		res = fmt.Sprintf("%s&vgw_id=%v", res, v)
+	}
+	if v, ok := d.GetOk("gateway_ip"); ok {
+		res = fmt.Sprintf("%s&vgw_ip=%v", res, v)
+	}
+	return res
+}
+
+func queryConnections(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]interface{}, error) {
+	listPath := client.Endpoint + "v5/{project_id}/vpn-connection"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath += buildConnectionsQueryParams(d)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	result := make([]interface{}, 0)
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath = fmt.Sprintf("%s&marker=%s", listPath, marker)
+		}
+		resp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		connections := utils.PathSearch("vpn_connections", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, connections...)
+
+		marker = utils.PathSearch("page_info.next_marker", respBody, "").(string)
+		if marker == "" || len(connections) < 1 {
+			break
+		}
+	}
+	return result, nil
+}
+
+// queryConnectionHealthCheckStatus returns the health check status of each VPN connection, the key is the connection ID.
+func queryConnectionHealthCheckStatus(client *golangsdk.ServiceClient) (map[string]interface{}, error) {
+	listPath := client.Endpoint + "v5/{project_id}/connection-monitors"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{})
+	monitors := utils.PathSearch("connection_monitors", respBody, make([]interface{}, 0)).([]interface{})
+	for _, monitor := range monitors {
+		result[utils.PathSearch("vpn_connection_id", monitor, "").(string)] = utils.PathSearch("status", monitor, nil)
+	}
+	return result, nil
+}
+
+func flattenConnections(d *schema.ResourceData, all []interface{}, healthChecks map[string]interface{}) []map[string]interface{} {
+	var (
+		connectionId = d.Get("connection_id").(string)
+		name         = d.Get("name").(string)
+		vpnType      = d.Get("vpn_type").(string)
+		status       = d.Get("status").(string)
+		result       = make([]map[string]interface{}, 0, len(all))
+	)
+
+	for _, connection := range all {
+		id := utils.PathSearch("id", connection, "").(string)
+		if connectionId != "" && id != connectionId {
+			continue
+		}
+		if name != "" && utils.PathSearch("name", connection, "").(string) != name {
+			continue
+		}
+		if vpnType != "" && !strings.EqualFold(utils.PathSearch("style", connection, "").(string), vpnType) {
+			continue
+		}
+		if status != "" && utils.PathSearch("status", connection, "").(string) != status {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                   id,
+			"name":                 utils.PathSearch("name", connection, nil),
+			"gateway_id":           utils.PathSearch("vgw_id", connection, nil),
+			"gateway_ip":           utils.PathSearch("vgw_ip", connection, nil),
+			"customer_gateway_id":  utils.PathSearch("cgw_id", connection, nil),
+			"vpn_type":             strings.ToLower(utils.PathSearch("style", connection, "").(string)),
+			"ha_role":              utils.PathSearch("ha_role", connection, nil),
+			"tunnel_local_address": utils.PathSearch("tunnel_local_address", connection, nil),
+			"tunnel_peer_address":  utils.PathSearch("tunnel_peer_address", connection, nil),
+			"enable_nqa":           utils.PathSearch("enable_nqa", connection, false),
+			"status":               utils.PathSearch("status", connection, nil),
+			"health_check_status":  healthChecks[id],
+			"created_at":           utils.PathSearch("created_at", connection, nil),
+			"updated_at":           utils.PathSearch("updated_at", connection, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceConnectionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	connections, err := queryConnections(client, d)
+	if err != nil {
+		return diag.Errorf("error retrieving VPN connections: %s", err)
+	}
+	healthChecks, err := queryConnectionHealthCheckStatus(client)
+	if err != nil {
+		return diag.Errorf("error retrieving VPN connection health checks: %s", err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connections", flattenConnections(d, connections, healthChecks)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error saving VPN connection list field: %s", mErr)
+	}
+	return nil
+}
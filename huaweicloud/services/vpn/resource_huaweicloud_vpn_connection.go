@@ -32,6 +32,7 @@ func ResourceConnection() *schema.Resource {
 		UpdateContext: resourceConnectionUpdate,
 		ReadContext:   resourceConnectionRead,
 		DeleteContext: resourceConnectionDelete,
+		CustomizeDiff: resourceConnectionCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -91,7 +92,8 @@ func ResourceConnection() *schema.Resource {
 			"peer_subnets": {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: `The customer subnets.`,
 			},
 			"psk": {
@@ -118,6 +120,16 @@ func ResourceConnection() *schema.Resource {
 				Computed:    true,
 				Description: `Whether to enable NQA check.`,
 			},
+			"ha_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The role of the connection when the gateway works in active-standby mode.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"master", "slave",
+				}, false),
+			},
 			"enterprise_project_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -354,6 +366,26 @@ func ConnectionPolicyRuleSchema() *schema.Resource {
 	return &sc
 }
 
+// resourceConnectionCustomizeDiff checks the arguments required by the connection type: the BGP connections exchange
+// routes through the tunnel interfaces, and the other connections route the traffic to the customer subnets.
+func resourceConnectionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("vpn_type") {
+		return nil
+	}
+
+	if strings.EqualFold(d.Get("vpn_type").(string), "bgp") {
+		if d.NewValueKnown("tunnel_local_address") && d.Get("tunnel_local_address").(string) == "" ||
+			d.NewValueKnown("tunnel_peer_address") && d.Get("tunnel_peer_address").(string) == "" {
+			return fmt.Errorf("both tunnel_local_address and tunnel_peer_address are required when vpn_type is bgp")
+		}
+		return nil
+	}
+	if d.NewValueKnown("peer_subnets") && len(d.Get("peer_subnets").([]interface{})) < 1 {
+		return fmt.Errorf("peer_subnets is required when vpn_type is %s", d.Get("vpn_type"))
+	}
+	return nil
+}
+
 func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
@@ -420,6 +452,7 @@ func buildCreateConnectionVpnConnectionChildBody(d *schema.ResourceData, config
 		"tunnel_local_address":  utils.ValueIngoreEmpty(d.Get("tunnel_local_address")),
 		"tunnel_peer_address":   utils.ValueIngoreEmpty(d.Get("tunnel_peer_address")),
 		"enable_nqa":            utils.ValueIngoreEmpty(d.Get("enable_nqa")),
+		"ha_role":               utils.ValueIngoreEmpty(d.Get("ha_role")),
 		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, config)),
 		"ikepolicy":             buildCreateConnectionIkepolicyChildBody(d),
 		"ipsecpolicy":           buildCreateConnectionIpsecpolicyChildBody(d),
@@ -608,6 +641,7 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("tunnel_local_address", utils.PathSearch("vpn_connection.tunnel_local_address", getConnectionRespBody, nil)),
 		d.Set("tunnel_peer_address", utils.PathSearch("vpn_connection.tunnel_peer_address", getConnectionRespBody, nil)),
 		d.Set("enable_nqa", utils.PathSearch("vpn_connection.enable_nqa", getConnectionRespBody, nil)),
+		d.Set("ha_role", utils.PathSearch("vpn_connection.ha_role", getConnectionRespBody, nil)),
 		d.Set("enterprise_project_id", utils.PathSearch("vpn_connection.enterprise_project_id", getConnectionRespBody, nil)),
 		d.Set("ikepolicy", flattenGetConnectionResponseBodyCreateRequestIkePolicy(getConnectionRespBody)),
 		d.Set("ipsecpolicy", flattenGetConnectionResponseBodyCreateRequestIpsecPolicy(getConnectionRespBody)),
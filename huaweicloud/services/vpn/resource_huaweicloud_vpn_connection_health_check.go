@@ -0,0 +1,167 @@
+package vpn
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceConnectionHealthCheck is used to manage the NQA monitor of the VPN connection, which probes the peer tunnel
+// interface address to detect the connectivity of the tunnel.
+func ResourceConnectionHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConnectionHealthCheckCreate,
+		ReadContext:   resourceConnectionHealthCheckRead,
+		DeleteContext: resourceConnectionHealthCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the VPN connection to monitor.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the health check.`,
+			},
+			"source_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The source IP address of the health check.`,
+			},
+			"destination_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The destination IP address of the health check.`,
+			},
+			"proto_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The protocol used by the health check.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the health check.`,
+			},
+		},
+	}
+}
+
+func resourceConnectionHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v5/{project_id}/connection-monitors"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"connection_monitor": map[string]interface{}{
+				"vpn_connection_id": d.Get("connection_id"),
+			},
+		},
+		OkCodes: []int{201},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating VPN connection health check: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("connection_monitor.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the VPN connection health check ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceConnectionHealthCheckRead(ctx, d, meta)
+}
+
+// GetConnectionHealthCheck is a method to query the connection monitor detail by its ID.
+func GetConnectionHealthCheck(client *golangsdk.ServiceClient, monitorId string) (interface{}, error) {
+	getPath := client.Endpoint + "v5/{project_id}/connection-monitors/{id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{id}", monitorId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func resourceConnectionHealthCheckRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("vpn", region)
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	respBody, err := GetConnectionHealthCheck(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "VPN connection health check")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connection_id", utils.PathSearch("connection_monitor.vpn_connection_id", respBody, nil)),
+		d.Set("type", utils.PathSearch("connection_monitor.type", respBody, nil)),
+		d.Set("source_ip", utils.PathSearch("connection_monitor.source_ip", respBody, nil)),
+		d.Set("destination_ip", utils.PathSearch("connection_monitor.destination_ip", respBody, nil)),
+		d.Set("proto_type", utils.PathSearch("connection_monitor.proto_type", respBody, nil)),
+		d.Set("status", utils.PathSearch("connection_monitor.status", respBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceConnectionHealthCheckDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("vpn", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPN client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v5/{project_id}/connection-monitors/{id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting VPN connection health check")
+	}
+	return nil
+}
@@ -59,20 +59,24 @@ func ResourceGateway() *schema.Resource {
 				),
 			},
 			"vpc_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The ID of the VPC to which the VPN gateway is connected.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"local_subnets", "connect_subnet"},
+				Description:  `The ID of the VPC to which the VPN gateway is connected.`,
 			},
 			"local_subnets": {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: `The local subnets.`,
 			},
 			"connect_subnet": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				Description: `The VPC network segment used by the VPN gateway needs to select an independent network segment in the VPC for the VPN gateway
 `,
@@ -105,9 +109,38 @@ func ResourceGateway() *schema.Resource {
 				ForceNew:    true,
 				Description: `The attachment type.`,
 				ValidateFunc: validation.StringInSlice([]string{
-					"vpc",
+					"vpc", "er",
 				}, false),
 			},
+			"er_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"vpc_id"},
+				Description:  `The ID of the ER instance to which the VPN gateway is attached.`,
+			},
+			"access_vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"er_id", "access_subnet_id"},
+				Description:  `The ID of the VPC where the access subnet of the VPN gateway is located.`,
+			},
+			"access_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"er_id", "access_vpc_id"},
+				Description:  `The ID of the subnet used by the VPN gateway to access the ER instance.`,
+			},
+			"er_attachment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the ER attachment created for the VPN gateway.`,
+			},
 			"flavor": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -125,6 +158,16 @@ func ResourceGateway() *schema.Resource {
 				ForceNew:    true,
 				Description: `The ASN number of BGP`,
 			},
+			"ha_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The HA mode of the VPN gateway. The value can be **active-active** and **active-standby**.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active-active", "active-standby",
+				}, false),
+			},
 			"enterprise_project_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -300,8 +343,12 @@ func buildCreateGatewayVpnGatewayChildBody(d *schema.ResourceData, config *confi
 		"availability_zone_ids": utils.ValueIngoreEmpty(d.Get("availability_zones")),
 		"bgp_asn":               utils.ValueIngoreEmpty(d.Get("asn")),
 		"connect_subnet":        utils.ValueIngoreEmpty(d.Get("connect_subnet")),
+		"er_id":                 utils.ValueIngoreEmpty(d.Get("er_id")),
+		"access_vpc_id":         utils.ValueIngoreEmpty(d.Get("access_vpc_id")),
+		"access_subnet_id":      utils.ValueIngoreEmpty(d.Get("access_subnet_id")),
 		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, config)),
 		"flavor":                utils.ValueIngoreEmpty(d.Get("flavor")),
+		"ha_mode":               utils.ValueIngoreEmpty(d.Get("ha_mode")),
 		"local_subnets":         utils.ValueIngoreEmpty(d.Get("local_subnets")),
 		"name":                  utils.ValueIngoreEmpty(d.Get("name")),
 		"vpc_id":                utils.ValueIngoreEmpty(d.Get("vpc_id")),
@@ -459,9 +506,14 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("availability_zones", utils.PathSearch("vpn_gateway.availability_zone_ids", getGatewayRespBody, nil)),
 		d.Set("asn", utils.PathSearch("vpn_gateway.bgp_asn", getGatewayRespBody, nil)),
 		d.Set("connect_subnet", utils.PathSearch("vpn_gateway.connect_subnet", getGatewayRespBody, nil)),
+		d.Set("er_id", utils.PathSearch("vpn_gateway.er_id", getGatewayRespBody, nil)),
+		d.Set("access_vpc_id", utils.PathSearch("vpn_gateway.access_vpc_id", getGatewayRespBody, nil)),
+		d.Set("access_subnet_id", utils.PathSearch("vpn_gateway.access_subnet_id", getGatewayRespBody, nil)),
+		d.Set("er_attachment_id", utils.PathSearch("vpn_gateway.er_attachment_id", getGatewayRespBody, nil)),
 		d.Set("created_at", utils.PathSearch("vpn_gateway.created_at", getGatewayRespBody, nil)),
 		d.Set("enterprise_project_id", utils.PathSearch("vpn_gateway.enterprise_project_id", getGatewayRespBody, nil)),
 		d.Set("flavor", utils.PathSearch("vpn_gateway.flavor", getGatewayRespBody, nil)),
+		d.Set("ha_mode", utils.PathSearch("vpn_gateway.ha_mode", getGatewayRespBody, nil)),
 		d.Set("local_subnets", utils.PathSearch("vpn_gateway.local_subnets", getGatewayRespBody, nil)),
 		d.Set("master_eip", flattenGetGatewayResponseBodyResponseMasterEip(getGatewayRespBody)),
 		d.Set("name", utils.PathSearch("vpn_gateway.name", getGatewayRespBody, nil)),
@@ -0,0 +1,158 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The logic codes of the anti-crawler rule path, the index of the logic is its code.
+var antiCrawlerLogics = []string{
+	"", "contain", "not_contain", "equal", "not_equal", "prefix", "not_prefix", "suffix", "not_suffix",
+}
+
+// ResourceRuleAntiCrawler is the impl of huaweicloud_waf_rule_anti_crawler, which configures the paths protected (or
+// excluded) by the JavaScript anti-crawler.
+func ResourceRuleAntiCrawler() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleAntiCrawlerCreate,
+		ReadContext:   resourceRuleAntiCrawlerRead,
+		UpdateContext: resourceRuleAntiCrawlerUpdate,
+		DeleteContext: resourceRuleAntiCrawlerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protection_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"anticrawler_specific_url", "anticrawler_except_url",
+				}, false),
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(antiCrawlerLogics[1:], false),
+			},
+		},
+	}
+}
+
+func buildRuleAntiCrawlerBodyParams(d *schema.ResourceData) map[string]interface{} {
+	logic := 0
+	for i, v := range antiCrawlerLogics {
+		if v == d.Get("logic").(string) {
+			logic = i
+		}
+	}
+	return map[string]interface{}{
+		"name":  d.Get("name"),
+		"type":  d.Get("protection_mode"),
+		"url":   d.Get("path"),
+		"logic": logic,
+	}
+}
+
+func resourceRuleAntiCrawlerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeAntiCrawler,
+		buildRuleAntiCrawlerBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF anti-crawler rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleAntiCrawlerRead(ctx, d, meta)
+}
+
+func resourceRuleAntiCrawlerRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeAntiCrawler, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF anti-crawler rule")
+	}
+
+	var logic string
+	if code := int(utils.PathSearch("logic", rule, float64(0)).(float64)); code > 0 && code < len(antiCrawlerLogics) {
+		logic = antiCrawlerLogics[code]
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", rule, nil)),
+		d.Set("protection_mode", utils.PathSearch("type", rule, nil)),
+		d.Set("path", utils.PathSearch("url", rule, nil)),
+		d.Set("logic", logic),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF anti-crawler rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleAntiCrawlerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeAntiCrawler, d.Id(),
+		buildRuleAntiCrawlerBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating WAF anti-crawler rule: %s", err)
+	}
+	return resourceRuleAntiCrawlerRead(ctx, d, meta)
+}
+
+func resourceRuleAntiCrawlerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	if err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeAntiCrawler, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF anti-crawler rule")
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRuleCCProtection is the impl of huaweicloud_waf_rule_cc_protection, which limits the access frequency of
+// the requests. The rule works in advanced mode if the conditions are specified, otherwise it works in basic mode and
+// only matches the URL.
+func ResourceRuleCCProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleCCProtectionCreate,
+		ReadContext:   resourceRuleCCProtectionRead,
+		UpdateContext: resourceRuleCCProtectionUpdate,
+		DeleteContext: resourceRuleCCProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protective_action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"captcha", "block", "log", "dynamic_block",
+				}, false),
+			},
+			"rate_limit_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ip", "cookie", "header", "other", "policy", "domain", "url",
+				}, false),
+			},
+			"limit_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+			"limit_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 3600),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"url", "conditions"},
+			},
+			"conditions": wafRuleConditionSchema(false),
+			"user_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"other_user_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lock_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"unlock_num": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"block_page_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"application/json", "text/html", "text/xml",
+				}, false),
+				RequiredWith: []string{"page_content"},
+			},
+			"page_content": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"request_aggregation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"all_waf_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildRuleCCProtectionBodyParams(d *schema.ResourceData) map[string]interface{} {
+	action := map[string]interface{}{
+		"category": d.Get("protective_action"),
+	}
+	if v, ok := d.GetOk("block_page_type"); ok {
+		action["detail"] = map[string]interface{}{
+			"response": map[string]interface{}{
+				"content_type": v,
+				"content":      d.Get("page_content"),
+			},
+		}
+	}
+
+	params := map[string]interface{}{
+		"name":               d.Get("name"),
+		"tag_type":           d.Get("rate_limit_mode"),
+		"limit_num":          d.Get("limit_num"),
+		"limit_period":       d.Get("limit_period"),
+		"lock_time":          d.Get("lock_time"),
+		"unlock_num":         utils.ValueIngoreEmpty(d.Get("unlock_num")),
+		"tag_index":          utils.ValueIngoreEmpty(d.Get("user_identifier")),
+		"action":             action,
+		"domain_aggregation": d.Get("request_aggregation"),
+		"region_aggregation": d.Get("all_waf_instances"),
+		"description":        d.Get("description"),
+	}
+	if v, ok := d.GetOk("other_user_identifier"); ok {
+		params["tag_condition"] = map[string]interface{}{
+			"category": "referer",
+			"contents": []string{v.(string)},
+		}
+	}
+	// The rule works in advanced mode (1) if the conditions are specified.
+	if conditions := d.Get("conditions").([]interface{}); len(conditions) > 0 {
+		params["mode"] = 1
+		params["conditions"] = buildWafRuleConditions(conditions)
+	} else {
+		params["mode"] = 0
+		params["url"] = d.Get("url")
+	}
+	return params
+}
+
+func resourceRuleCCProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeCC,
+		buildRuleCCProtectionBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF CC protection rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleCCProtectionRead(ctx, d, meta)
+}
+
+func resourceRuleCCProtectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeCC, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF CC protection rule")
+	}
+
+	var otherUserIdentifier interface{}
+	if contents := utils.PathSearch("tag_condition.contents", rule, nil); contents != nil {
+		otherUserIdentifier = utils.PathSearch("[0]", contents, nil)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", rule, nil)),
+		d.Set("protective_action", utils.PathSearch("action.category", rule, nil)),
+		d.Set("rate_limit_mode", utils.PathSearch("tag_type", rule, nil)),
+		d.Set("limit_num", utils.PathSearch("limit_num", rule, nil)),
+		d.Set("limit_period", utils.PathSearch("limit_period", rule, nil)),
+		d.Set("url", utils.PathSearch("url", rule, nil)),
+		d.Set("conditions", flattenWafRuleConditions(utils.PathSearch("conditions", rule, nil))),
+		d.Set("user_identifier", utils.PathSearch("tag_index", rule, nil)),
+		d.Set("other_user_identifier", otherUserIdentifier),
+		d.Set("lock_time", utils.PathSearch("lock_time", rule, nil)),
+		d.Set("unlock_num", utils.PathSearch("unlock_num", rule, nil)),
+		d.Set("block_page_type", utils.PathSearch("action.detail.response.content_type", rule, nil)),
+		d.Set("page_content", utils.PathSearch("action.detail.response.content", rule, nil)),
+		d.Set("request_aggregation", utils.PathSearch("domain_aggregation", rule, false)),
+		d.Set("all_waf_instances", utils.PathSearch("region_aggregation", rule, false)),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF CC protection rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleCCProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeCC, d.Id(),
+		buildRuleCCProtectionBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating WAF CC protection rule: %s", err)
+	}
+	return resourceRuleCCProtectionRead(ctx, d, meta)
+}
+
+func resourceRuleCCProtectionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	if err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeCC, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF CC protection rule")
+	}
+	return nil
+}
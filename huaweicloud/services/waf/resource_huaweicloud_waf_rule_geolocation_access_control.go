@@ -0,0 +1,142 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRuleGeolocation is the impl of huaweicloud_waf_rule_geolocation_access_control, which controls the access
+// of the requests from the specified locations.
+func ResourceRuleGeolocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleGeolocationCreate,
+		ReadContext:   resourceRuleGeolocationRead,
+		UpdateContext: resourceRuleGeolocationUpdate,
+		DeleteContext: resourceRuleGeolocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"geolocation": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"action": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  PROTECTION_ACTION_BLOCK,
+				ValidateFunc: validation.IntInSlice([]int{
+					PROTECTION_ACTION_BLOCK, PROTECTION_ACTION_ALLOW, PROTECTION_ACTION_LOG,
+				}),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildRuleGeolocationBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name"),
+		"geoip":       d.Get("geolocation"),
+		"white":       d.Get("action"),
+		"description": d.Get("description"),
+	}
+}
+
+func resourceRuleGeolocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeGeolocation,
+		buildRuleGeolocationBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF geolocation access control rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleGeolocationRead(ctx, d, meta)
+}
+
+func resourceRuleGeolocationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeGeolocation, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF geolocation access control rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", rule, nil)),
+		d.Set("geolocation", utils.PathSearch("geoip", rule, nil)),
+		d.Set("action", utils.PathSearch("white", rule, nil)),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF geolocation access control rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleGeolocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeGeolocation, d.Id(),
+		buildRuleGeolocationBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating WAF geolocation access control rule: %s", err)
+	}
+	return resourceRuleGeolocationRead(ctx, d, meta)
+}
+
+func resourceRuleGeolocationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	if err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeGeolocation, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF geolocation access control rule")
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRuleGlobalProtectionWhitelist is the impl of huaweicloud_waf_rule_global_protection_whitelist (also known
+// as false alarm masking), which ignores the protection of the requests matched the conditions.
+func ResourceRuleGlobalProtectionWhitelist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleGlobalProtectionWhitelistCreate,
+		ReadContext:   resourceRuleGlobalProtectionWhitelistRead,
+		UpdateContext: resourceRuleGlobalProtectionWhitelistUpdate,
+		DeleteContext: resourceRuleGlobalProtectionWhitelistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domains": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"conditions": wafRuleConditionSchema(true),
+			"ignore_waf_protection": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"advanced_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"params", "cookie", "header", "body", "multipart",
+				}, false),
+			},
+			"advanced_content": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"advanced_field"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildRuleGlobalProtectionWhitelistBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"domain":      utils.ExpandToStringList(d.Get("domains").([]interface{})),
+		"conditions":  buildWafRuleConditions(d.Get("conditions").([]interface{})),
+		"mode":        1,
+		"rule":        d.Get("ignore_waf_protection"),
+		"description": d.Get("description"),
+	}
+	if v, ok := d.GetOk("advanced_field"); ok {
+		params["advanced"] = map[string]interface{}{
+			"index":    v,
+			"contents": utils.ExpandToStringList(d.Get("advanced_content").([]interface{})),
+		}
+	}
+	return params
+}
+
+func resourceRuleGlobalProtectionWhitelistCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeGlobalWhitelist,
+		buildRuleGlobalProtectionWhitelistBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF global protection whitelist rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleGlobalProtectionWhitelistRead(ctx, d, meta)
+}
+
+func resourceRuleGlobalProtectionWhitelistRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeGlobalWhitelist, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF global protection whitelist rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("domains", utils.PathSearch("domain", rule, nil)),
+		d.Set("conditions", flattenWafRuleConditions(utils.PathSearch("conditions", rule, nil))),
+		d.Set("ignore_waf_protection", utils.PathSearch("rule", rule, nil)),
+		d.Set("advanced_field", utils.PathSearch("advanced.index", rule, nil)),
+		d.Set("advanced_content", utils.PathSearch("advanced.contents", rule, nil)),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF global protection whitelist rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleGlobalProtectionWhitelistUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeGlobalWhitelist, d.Id(),
+		buildRuleGlobalProtectionWhitelistBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating WAF global protection whitelist rule: %s", err)
+	}
+	return resourceRuleGlobalProtectionWhitelistRead(ctx, d, meta)
+}
+
+func resourceRuleGlobalProtectionWhitelistDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	if err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeGlobalWhitelist, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF global protection whitelist rule")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package waf
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRuleLeakagePrevention is the impl of huaweicloud_waf_rule_information_leakage_prevention, which prevents
+// the sensitive information and the error codes from being leaked in the responses.
+func ResourceRuleLeakagePrevention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleLeakagePreventionCreate,
+		ReadContext:   resourceRuleLeakagePreventionRead,
+		UpdateContext: resourceRuleLeakagePreventionUpdate,
+		DeleteContext: resourceRuleLeakagePreventionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"code", "sensitive"}, false),
+			},
+			"contents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protective_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "log",
+				ValidateFunc: validation.StringInSlice([]string{"block", "log"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildRuleLeakagePreventionBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"url":         d.Get("path"),
+		"category":    d.Get("type"),
+		"contents":    utils.ExpandToStringList(d.Get("contents").([]interface{})),
+		"action":      map[string]interface{}{"category": d.Get("protective_action")},
+		"description": d.Get("description"),
+	}
+}
+
+func resourceRuleLeakagePreventionCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeInformationLeakage,
+		buildRuleLeakagePreventionBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF information leakage prevention rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleLeakagePreventionRead(ctx, d, meta)
+}
+
+func resourceRuleLeakagePreventionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeInformationLeakage, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF information leakage prevention rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("path", utils.PathSearch("url", rule, nil)),
+		d.Set("type", utils.PathSearch("category", rule, nil)),
+		d.Set("contents", utils.PathSearch("contents", rule, nil)),
+		d.Set("protective_action", utils.PathSearch("action.category", rule, nil)),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF information leakage prevention rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleLeakagePreventionUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeInformationLeakage, d.Id(),
+		buildRuleLeakagePreventionBodyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating WAF information leakage prevention rule: %s", err)
+	}
+	return resourceRuleLeakagePreventionRead(ctx, d, meta)
+}
+
+func resourceRuleLeakagePreventionDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeInformationLeakage, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF information leakage prevention rule")
+	}
+	return nil
+}
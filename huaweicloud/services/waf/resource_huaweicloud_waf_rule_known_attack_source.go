@@ -0,0 +1,159 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRuleKnownAttackSource is the impl of huaweicloud_waf_rule_known_attack_source, which blocks the attack
+// source (IP, cookie or parameter) for a period of time after the attack is detected.
+func ResourceRuleKnownAttackSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleKnownAttackSourceCreate,
+		ReadContext:   resourceRuleKnownAttackSourceRead,
+		UpdateContext: resourceRuleKnownAttackSourceUpdate,
+		DeleteContext: resourceRuleKnownAttackSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"block_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"long_ip_block", "long_cookie_block", "long_params_block",
+					"short_ip_block", "short_cookie_block", "short_params_block",
+				}, false),
+			},
+			"block_time": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// The long-term blocking lasts 301 to 1800 seconds, and the short-term blocking lasts 0 to 300 seconds.
+func buildRuleKnownAttackSourceBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	blockType := d.Get("block_type").(string)
+	blockTime := d.Get("block_time").(int)
+	if strings.HasPrefix(blockType, "long_") && (blockTime < 301 || blockTime > 1800) {
+		return nil, fmt.Errorf("the block_time of %s must be between 301 and 1800 seconds", blockType)
+	}
+	if strings.HasPrefix(blockType, "short_") && (blockTime < 0 || blockTime > 300) {
+		return nil, fmt.Errorf("the block_time of %s must be between 0 and 300 seconds", blockType)
+	}
+
+	return map[string]interface{}{
+		"category":    blockType,
+		"block_time":  blockTime,
+		"description": d.Get("description"),
+	}, nil
+}
+
+func resourceRuleKnownAttackSourceCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	params, err := buildRuleKnownAttackSourceBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypeKnownAttackSource, params)
+	if err != nil {
+		return diag.Errorf("error creating WAF known attack source rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRuleKnownAttackSourceRead(ctx, d, meta)
+}
+
+func resourceRuleKnownAttackSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypeKnownAttackSource, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF known attack source rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("block_type", utils.PathSearch("category", rule, nil)),
+		d.Set("block_time", utils.PathSearch("block_time", rule, nil)),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF known attack source rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRuleKnownAttackSourceUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	params, err := buildRuleKnownAttackSourceBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypeKnownAttackSource, d.Id(), params)
+	if err != nil {
+		return diag.Errorf("error updating WAF known attack source rule: %s", err)
+	}
+	return resourceRuleKnownAttackSourceRead(ctx, d, meta)
+}
+
+func resourceRuleKnownAttackSourceDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypeKnownAttackSource, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF known attack source rule")
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRulePreciseProtection is the impl of huaweicloud_waf_rule_precise_protection, which matches the requests
+// by multiple conditions and takes the action on them. The rule can take effect in a period of time.
+func ResourceRulePreciseProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRulePreciseProtectionCreate,
+		ReadContext:   resourceRulePreciseProtectionRead,
+		UpdateContext: resourceRulePreciseProtectionUpdate,
+		DeleteContext: resourceRulePreciseProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWafRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"conditions": wafRuleConditionSchema(true),
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "block",
+				ValidateFunc: validation.StringInSlice([]string{"block", "pass", "log"}, false),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"end_time"},
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_time"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildRulePreciseProtectionBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"name":        d.Get("name"),
+		"priority":    d.Get("priority"),
+		"conditions":  buildWafRuleConditions(d.Get("conditions").([]interface{})),
+		"action":      map[string]interface{}{"category": d.Get("action")},
+		"description": d.Get("description"),
+		"time":        false,
+	}
+
+	// The rule takes effect immediately if the effective period is not specified.
+	if v, ok := d.GetOk("start_time"); ok {
+		start, err := utils.FormatUTCTimeStamp(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_time: %s", err)
+		}
+		terminal, err := utils.FormatUTCTimeStamp(d.Get("end_time").(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_time: %s", err)
+		}
+		params["time"] = true
+		params["start"] = start
+		params["terminal"] = terminal
+	}
+	return params, nil
+}
+
+func resourceRulePreciseProtectionCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	params, err := buildRulePreciseProtectionBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId, err := createWafRule(client, d.Get("policy_id").(string), wafRuleTypePreciseProtection, params)
+	if err != nil {
+		return diag.Errorf("error creating WAF precise protection rule: %s", err)
+	}
+	d.SetId(ruleId)
+
+	return resourceRulePreciseProtectionRead(ctx, d, meta)
+}
+
+func resourceRulePreciseProtectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.WafV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	rule, err := getWafRule(client, d.Get("policy_id").(string), wafRuleTypePreciseProtection, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving WAF precise protection rule")
+	}
+
+	var startTime, endTime string
+	if utils.PathSearch("time", rule, false).(bool) {
+		startTime = utils.FormatTimeStampUTC(int64(utils.PathSearch("start", rule, float64(0)).(float64)))
+		endTime = utils.FormatTimeStampUTC(int64(utils.PathSearch("terminal", rule, float64(0)).(float64)))
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", rule, nil)),
+		d.Set("priority", utils.PathSearch("priority", rule, nil)),
+		d.Set("conditions", flattenWafRuleConditions(utils.PathSearch("conditions", rule, nil))),
+		d.Set("action", utils.PathSearch("action.category", rule, nil)),
+		d.Set("start_time", startTime),
+		d.Set("end_time", endTime),
+		d.Set("description", utils.PathSearch("description", rule, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting WAF precise protection rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRulePreciseProtectionUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	params, err := buildRulePreciseProtectionBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateWafRule(client, d.Get("policy_id").(string), wafRuleTypePreciseProtection, d.Id(), params)
+	if err != nil {
+		return diag.Errorf("error updating WAF precise protection rule: %s", err)
+	}
+	return resourceRulePreciseProtectionRead(ctx, d, meta)
+}
+
+func resourceRulePreciseProtectionDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.WafV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating WAF client: %s", err)
+	}
+
+	err = deleteWafRule(client, d.Get("policy_id").(string), wafRuleTypePreciseProtection, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting WAF precise protection rule")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package waf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The path segments of the WAF rule types, the rule URL is /v1/{project_id}/waf/policy/{policy_id}/{rule type}.
+const (
+	wafRuleTypeCC                 = "cc"
+	wafRuleTypePreciseProtection  = "custom"
+	wafRuleTypeGeolocation        = "geoip"
+	wafRuleTypeGlobalWhitelist    = "ignore"
+	wafRuleTypeKnownAttackSource  = "punishment"
+	wafRuleTypeInformationLeakage = "antileakage"
+	wafRuleTypeAntiCrawler        = "anticrawler"
+)
+
+var wafRuleConditionFields = []string{
+	"url", "user-agent", "ip", "params", "cookie", "referer", "header", "method", "request_line", "request",
+	"response_code", "response_header", "response_body",
+}
+
+// wafRuleConditionSchema is the condition schema shared by the CC attack, precise protection and global protection
+// whitelist rules, all conditions of the rule must be matched.
+func wafRuleConditionSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafRuleConditionFields, false),
+				},
+				"logic": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"subfield": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"content": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"reference_table_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func buildWafRuleConditions(rawConditions []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rawConditions))
+	for _, v := range rawConditions {
+		condition := v.(map[string]interface{})
+		result = append(result, utils.RemoveNil(map[string]interface{}{
+			"category":        condition["field"],
+			"logic_operation": condition["logic"],
+			"index":           utils.ValueIngoreEmpty(condition["subfield"]),
+			"contents":        utils.ValueIngoreEmpty(utils.ExpandToStringList(condition["content"].([]interface{}))),
+			"value_list_id":   utils.ValueIngoreEmpty(condition["reference_table_id"]),
+		}))
+	}
+	return result
+}
+
+func flattenWafRuleConditions(rawConditions interface{}) []map[string]interface{} {
+	conditions, ok := rawConditions.([]interface{})
+	if !ok || len(conditions) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, map[string]interface{}{
+			"field":              utils.PathSearch("category", condition, nil),
+			"logic":              utils.PathSearch("logic_operation", condition, nil),
+			"subfield":           utils.PathSearch("index", condition, nil),
+			"content":            utils.PathSearch("contents", condition, nil),
+			"reference_table_id": utils.PathSearch("value_list_id", condition, nil),
+		})
+	}
+	return result
+}
+
+func createWafRule(client *golangsdk.ServiceClient, policyId, ruleType string,
+	params map[string]interface{}) (string, error) {
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(params),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("policy", policyId, ruleType), &createOpt)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	ruleId := utils.PathSearch("id", respBody, "").(string)
+	if ruleId == "" {
+		return "", fmt.Errorf("unable to find the rule ID from the API response")
+	}
+	return ruleId, nil
+}
+
+func getWafRule(client *golangsdk.ServiceClient, policyId, ruleType, ruleId string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	resp, err := client.Request("GET", client.ServiceURL("policy", policyId, ruleType, ruleId), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func updateWafRule(client *golangsdk.ServiceClient, policyId, ruleType, ruleId string,
+	params map[string]interface{}) error {
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(params),
+	}
+	_, err := client.Request("PUT", client.ServiceURL("policy", policyId, ruleType, ruleId), &updateOpt)
+	return err
+}
+
+func deleteWafRule(client *golangsdk.ServiceClient, policyId, ruleType, ruleId string) error {
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	_, err := client.Request("DELETE", client.ServiceURL("policy", policyId, ruleType, ruleId), &deleteOpt)
+	return err
+}
+
+// resourceWafRuleImportState is the context version of resourceWafRulesImport, the format of the import ID is
+// <policy_id>/<rule_id>.
+func resourceWafRuleImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	return resourceWafRulesImport(d, meta)
+}
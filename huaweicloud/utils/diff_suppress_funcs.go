@@ -16,6 +16,12 @@ import (
 )
 
 func SuppressEquivalentAwsPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	// The Huawei policies, such as the IAM custom policies, use the list of condition values and the resource objects
+	// which are not supported by the AWS policy equivalence check, so check them first.
+	if equivalent, err := PoliciesAreEquivalent(old, new); err == nil && equivalent {
+		return true
+	}
+
 	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
@@ -137,3 +143,9 @@ func CompareJsonTemplateAreEquivalent(tem1, tem2 string) (bool, error) {
 	}
 	return equal, nil
 }
+
+// SuppressEquivalentCIDRDiffs is used to ignore the format differences of the equivalent CIDRs, such as the IPv6
+// CIDRs in upper case or with leading zeros.
+func SuppressEquivalentCIDRDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	return IsSameCIDR(old, new)
+}
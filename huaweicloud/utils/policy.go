@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+)
+
+// policyListKeys are the statement elements whose values are unordered string lists. A single string is equivalent
+// to a list which contains only the string, e.g. "Action": "obs:bucket:GetObject".
+var policyListKeys = map[string]bool{
+	"Action":       true,
+	"NotAction":    true,
+	"Resource":     true,
+	"NotResource":  true,
+	"Principal":    true,
+	"NotPrincipal": true,
+	"Condition":    true,
+}
+
+// CanonicalizePolicy returns the canonical JSON of the Huawei IAM or OBS policy document. The statements are sorted,
+// the lists of actions, resources, principals and condition values are sorted and deduplicated, and the single string
+// values of them are converted to lists.
+func CanonicalizePolicy(policy string) (string, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return "", fmt.Errorf("error parsing policy document: %s", err)
+	}
+
+	if raw, ok := doc["Statement"]; ok {
+		statements, ok := raw.([]interface{})
+		if !ok {
+			statements = []interface{}{raw}
+		}
+
+		canonicalStatements := make([]string, 0, len(statements))
+		statementMap := make(map[string]interface{}, len(statements))
+		for _, s := range statements {
+			statement, ok := s.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("invalid policy statement: %v", s)
+			}
+			for k, v := range statement {
+				if policyListKeys[k] {
+					statement[k] = normalizePolicyValue(v)
+				}
+			}
+			b, err := json.Marshal(statement)
+			if err != nil {
+				return "", err
+			}
+			// The duplicate statements are removed.
+			if _, ok := statementMap[string(b)]; !ok {
+				canonicalStatements = append(canonicalStatements, string(b))
+				statementMap[string(b)] = statement
+			}
+		}
+		sort.Strings(canonicalStatements)
+
+		result := make([]interface{}, 0, len(canonicalStatements))
+		for _, s := range canonicalStatements {
+			result = append(result, statementMap[s])
+		}
+		doc["Statement"] = result
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// normalizePolicyValue converts the string to a list, sorts and deduplicates the string list, and normalizes the
+// values of the map recursively, e.g. the condition operators and keys.
+func normalizePolicyValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return []interface{}{value}
+	case []interface{}:
+		strs := make([]string, 0, len(value))
+		for _, item := range value {
+			str, ok := item.(string)
+			if !ok {
+				// Keep the original order if the list contains any non-string element.
+				return value
+			}
+			strs = append(strs, str)
+		}
+		strs = RemoveDuplicateElem(strs)
+		sort.Strings(strs)
+
+		result := make([]interface{}, 0, len(strs))
+		for _, str := range strs {
+			result = append(result, str)
+		}
+		return result
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = normalizePolicyValue(item)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// PoliciesAreEquivalent checks whether the two Huawei IAM or OBS policy documents are equivalent, the differences of
+// the whitespaces, the order of the statements and the list elements are ignored.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	canonical1, err := CanonicalizePolicy(policy1)
+	if err != nil {
+		return false, err
+	}
+	canonical2, err := CanonicalizePolicy(policy2)
+	if err != nil {
+		return false, err
+	}
+
+	equal := canonical1 == canonical2
+	if !equal {
+		log.Printf("[DEBUG] Canonical policies are not equal.\nFirst: %s\nSecond: %s\n", canonical1, canonical2)
+	}
+	return equal, nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"log"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"regexp"
@@ -303,6 +304,26 @@ func IsIPv4Address(addr string) bool {
 	return matched
 }
 
+// IsIPv6Address is used to check whether the addr string is IPv6 format
+func IsIPv6Address(addr string) bool {
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.To4() == nil
+}
+
+// IsSameCIDR is used to check whether two CIDR strings represent the same network, e.g. "2001:DB8::/64" and
+// "2001:db8:0::/64".
+func IsSameCIDR(cidr1, cidr2 string) bool {
+	ip1, net1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return cidr1 == cidr2
+	}
+	ip2, net2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false
+	}
+	return ip1.Equal(ip2) && net1.String() == net2.String()
+}
+
 // This function compares whether there is a containment relationship between two maps, that is,
 // whether map A (rawMap) contains map B (filterMap).
 //
@@ -55,3 +55,85 @@ func TestAccFunction_RemoveNil(t *testing.T) {
 	}
 	t.Logf("The processing result of RemoveNil method meets expectation: %s", green(expected))
 }
+
+func TestAccFunction_ValidateCIDR(t *testing.T) {
+	var (
+		validCIDRs   = []string{"192.168.0.0/16", "2001:db8::/64", "2001:DB8:0::/64", "::/0"}
+		invalidCIDRs = []string{"192.168.0.1/16", "2001:db8::1/64", "192.168.0.0", "2001:db8::/129"}
+	)
+
+	for _, cidr := range validCIDRs {
+		if _, errs := ValidateCIDR(cidr, "cidr"); len(errs) > 0 {
+			t.Fatalf("The CIDR %s should be valid, but got errors: %v", green(cidr), errs)
+		}
+	}
+	for _, cidr := range invalidCIDRs {
+		if _, errs := ValidateCIDR(cidr, "cidr"); len(errs) == 0 {
+			t.Fatalf("The CIDR %s should be invalid, but no error is returned", yellow(cidr))
+		}
+	}
+	t.Logf("The processing result of ValidateCIDR method meets expectation")
+}
+
+func TestAccFunction_ValidateIPRange(t *testing.T) {
+	var (
+		validRanges   = []string{"192.168.0.1-192.168.0.255", "10.0.0.9-10.0.0.10", "2001:db8::1-2001:db8::ff"}
+		invalidRanges = []string{"192.168.0.255-192.168.0.1", "10.0.0.1-10.0.0.1", "192.168.0.1-2001:db8::ff",
+			"2001:db8::ff-2001:db8::1", "192.168.0.1"}
+	)
+
+	for _, ipRange := range validRanges {
+		if _, errs := ValidateIPRange(ipRange, "ip_range"); len(errs) > 0 {
+			t.Fatalf("The IP range %s should be valid, but got errors: %v", green(ipRange), errs)
+		}
+	}
+	for _, ipRange := range invalidRanges {
+		if _, errs := ValidateIPRange(ipRange, "ip_range"); len(errs) == 0 {
+			t.Fatalf("The IP range %s should be invalid, but no error is returned", yellow(ipRange))
+		}
+	}
+	t.Logf("The processing result of ValidateIPRange method meets expectation")
+}
+
+func TestAccFunction_IsSameCIDR(t *testing.T) {
+	if !IsSameCIDR("2001:DB8:0::/64", "2001:db8::/64") {
+		t.Fatalf("The equivalent IPv6 CIDRs should be the same")
+	}
+	if IsSameCIDR("2001:db8::/64", "2001:db8::/56") {
+		t.Fatalf("The IPv6 CIDRs with different prefix length should not be the same")
+	}
+	t.Logf("The processing result of IsSameCIDR method meets expectation")
+}
+
+func TestAccFunction_PoliciesAreEquivalent(t *testing.T) {
+	var (
+		policy = `{
+  "Version": "1.1",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["obs:object:GetObject", "obs:bucket:ListBucket"],
+      "Condition": {"StringEquals": {"g:UserName": ["foo", "bar"]}}
+    },
+    {"Effect": "Deny", "Action": "obs:bucket:DeleteBucket"}
+  ]
+}`
+		equivalentPolicy = `{"Statement":[{"Action":["obs:bucket:DeleteBucket"],"Effect":"Deny"},` +
+			`{"Action":["obs:bucket:ListBucket","obs:object:GetObject","obs:object:GetObject"],"Effect":"Allow",` +
+			`"Condition":{"StringEquals":{"g:UserName":["bar","foo"]}}}],"Version":"1.1"}`
+		differentPolicy = `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:bucket:ListBucket"]}]}`
+	)
+
+	equal, err := PoliciesAreEquivalent(policy, equivalentPolicy)
+	if err != nil || !equal {
+		t.Fatalf("The policies should be equivalent, but got %s: %v", yellow(equal), err)
+	}
+	equal, err = PoliciesAreEquivalent(policy, differentPolicy)
+	if err != nil || equal {
+		t.Fatalf("The policies should not be equivalent, but got %s: %v", yellow(equal), err)
+	}
+	if _, err = PoliciesAreEquivalent(policy, "{"); err == nil {
+		t.Fatalf("The invalid policy should not be parsed")
+	}
+	t.Logf("The processing result of PoliciesAreEquivalent method meets expectation")
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"regexp"
@@ -69,14 +70,16 @@ func ValidateString64WithChinese(v interface{}, k string) (ws []string, errors [
 
 func ValidateCIDR(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	_, ipnet, err := net.ParseCIDR(value)
+	ip, ipnet, err := net.ParseCIDR(value)
 	if err != nil {
 		errors = append(errors, fmt.Errorf(
 			"%q must contain a valid CIDR, got error parsing: %s", k, err))
 		return
 	}
 
-	if ipnet == nil || strings.ToLower(value) != ipnet.String() {
+	// The IPv6 CIDR can be written in several formats, so compare the network address instead of the string.
+	// The format differences of the equivalent CIDRs are suppressed by SuppressEquivalentCIDRDiffs.
+	if ipnet == nil || !ip.Equal(ipnet.IP) {
 		errors = append(errors, fmt.Errorf(
 			"%q must contain a valid network CIDR, got %q", k, value))
 	}
@@ -84,6 +87,8 @@ func ValidateCIDR(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateIPRange is used to check whether the value is a valid IPv4 or IPv6 address range, such as
+// 192.168.0.1-192.168.0.255 or 2001:db8::1-2001:db8::ff.
 func ValidateIPRange(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	ipAddresses := strings.Split(value, "-")
@@ -92,32 +97,29 @@ func ValidateIPRange(v interface{}, k string) (ws []string, errors []error) {
 			"%q must be a valid network IP address range, such as 0.0.0.0-255.255.255.0, but got %q", k, value))
 		return
 	}
-	for _, address := range ipAddresses {
-		ipnet := net.ParseIP(address)
-		if ipnet == nil || address != ipnet.String() {
+
+	ips := make([]net.IP, len(ipAddresses))
+	for i, address := range ipAddresses {
+		ips[i] = net.ParseIP(address)
+		if ips[i] == nil || strings.ToLower(address) != ips[i].String() {
 			errors = append(errors, fmt.Errorf("%q must contains valid network IP address, got %q", k, address))
 		}
 	}
-	if len(errors) == 0 {
-		if ipAddresses[0] == ipAddresses[1] {
-			errors = append(errors, fmt.Errorf("Two network IP address of %q cannot equal, got %q", k, value))
-		}
-		// Split the IP address into a string array for comparison.
-		startAddress := strings.Split(ipAddresses[0], ".")
-		endAddress := strings.Split(ipAddresses[1], ".")
-		// Verify the correctness of the IP address range: The starting IP address must be less than the ending IP address.
-		// The For loop compares the four parts of the IPv4 address in turn.
-		for i := 0; i < len(startAddress); i++ {
-			if startAddress[i] > endAddress[i] {
-				errors = append(errors, fmt.Errorf(
-					"%q starting IP address cannot be greater than the ending IP address, got %q", k, value))
-				return
-			} else if startAddress[i] < endAddress[i] {
-				return
-			}
-		}
+	if len(errors) > 0 {
+		return
 	}
 
+	if (ips[0].To4() == nil) != (ips[1].To4() == nil) {
+		errors = append(errors, fmt.Errorf("the IP addresses of %q must be in the same IP version, got %q", k, value))
+		return
+	}
+	switch bytes.Compare(ips[0].To16(), ips[1].To16()) {
+	case 0:
+		errors = append(errors, fmt.Errorf("Two network IP address of %q cannot equal, got %q", k, value))
+	case 1:
+		errors = append(errors, fmt.Errorf(
+			"%q starting IP address cannot be greater than the ending IP address, got %q", k, value))
+	}
 	return
 }
 
@@ -125,7 +127,8 @@ func ValidateIP(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	ipnet := net.ParseIP(value)
 
-	if ipnet == nil || value != ipnet.String() {
+	// The IPv6 address is case-insensitive.
+	if ipnet == nil || strings.ToLower(value) != ipnet.String() {
 		errors = append(errors, fmt.Errorf(
 			"%q must contain a valid network IP address, got %q", k, value))
 	}